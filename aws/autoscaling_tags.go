@@ -52,14 +52,14 @@ func autoscalingTagToHash(v interface{}) int {
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tag"
-func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) error {
+func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData, ignoreTagsConfig *IgnoreTagsConfig) error {
 	resourceID := d.Get("name").(string)
 	var createTags, removeTags []*autoscaling.Tag
 
 	if d.HasChange("tag") || d.HasChange("tags") {
 		oraw, nraw := d.GetChange("tag")
-		o := setToMapByKey(oraw.(*schema.Set), "key")
-		n := setToMapByKey(nraw.(*schema.Set), "key")
+		o := ignoreTagsConfig.RemoveIgnored(setToMapByKey(oraw.(*schema.Set), "key"))
+		n := ignoreTagsConfig.RemoveIgnored(setToMapByKey(nraw.(*schema.Set), "key"))
 
 		old, err := autoscalingTagsFromMap(o, resourceID)
 		if err != nil {
@@ -80,12 +80,12 @@ func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) e
 		removeTags = append(removeTags, r...)
 
 		oraw, nraw = d.GetChange("tags")
-		old, err = autoscalingTagsFromList(oraw.([]interface{}), resourceID)
+		old, err = autoscalingTagsFromList(removeIgnoredTagsList(oraw.([]interface{}), ignoreTagsConfig), resourceID)
 		if err != nil {
 			return err
 		}
 
-		new, err = autoscalingTagsFromList(nraw.([]interface{}), resourceID)
+		new, err = autoscalingTagsFromList(removeIgnoredTagsList(nraw.([]interface{}), ignoreTagsConfig), resourceID)
 		if err != nil {
 			return err
 		}
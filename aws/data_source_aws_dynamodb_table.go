@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -145,6 +146,26 @@ func dataSourceAwsDynamoDbTable() *schema.Resource {
 					return strings.ToUpper(value)
 				},
 			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"earliest_restorable_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"latest_restorable_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": TagsSchemaComputed(),
 			"ttl": {
 				Type:     schema.TypeSet,
@@ -216,6 +237,16 @@ func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	pitrOut, err := conn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading DynamoDB Table (%s) continuous backups: %s", d.Id(), err)
+	}
+	if err := d.Set("point_in_time_recovery", flattenDynamoDbPitrDataSource(pitrOut.ContinuousBackupsDescription)); err != nil {
+		return fmt.Errorf("Error setting point_in_time_recovery: %s", err)
+	}
+
 	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn)
 	if err != nil {
 		return err
@@ -224,3 +255,22 @@ func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func flattenDynamoDbPitrDataSource(desc *dynamodb.ContinuousBackupsDescription) []interface{} {
+	l := flattenDynamoDbPitr(desc)
+	m := l[0].(map[string]interface{})
+	m["earliest_restorable_date_time"] = ""
+	m["latest_restorable_date_time"] = ""
+
+	if desc != nil && desc.PointInTimeRecoveryDescription != nil {
+		pitr := desc.PointInTimeRecoveryDescription
+		if pitr.EarliestRestorableDateTime != nil {
+			m["earliest_restorable_date_time"] = aws.TimeValue(pitr.EarliestRestorableDateTime).Format(time.RFC3339)
+		}
+		if pitr.LatestRestorableDateTime != nil {
+			m["latest_restorable_date_time"] = aws.TimeValue(pitr.LatestRestorableDateTime).Format(time.RFC3339)
+		}
+	}
+
+	return l
+}
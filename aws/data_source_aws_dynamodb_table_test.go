@@ -29,6 +29,9 @@ func TestAccDataSourceAwsDynamoDbTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_dynamodb_table.dynamodb_table_test", "tags.Name", "dynamodb-table-1"),
 					resource.TestCheckResourceAttr("data.aws_dynamodb_table.dynamodb_table_test", "tags.Environment", "test"),
 					resource.TestCheckResourceAttr("data.aws_dynamodb_table.dynamodb_table_test", "server_side_encryption.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_dynamodb_table.dynamodb_table_test", "point_in_time_recovery.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_dynamodb_table.dynamodb_table_test", "point_in_time_recovery.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.aws_dynamodb_table.dynamodb_table_test", "point_in_time_recovery.0.earliest_restorable_date_time"),
 				),
 			},
 		},
@@ -68,6 +71,10 @@ func testAccDataSourceAwsDynamoDbTableConfigBasic(tableName string) string {
     non_key_attributes = ["UserId"]
   }
 
+  point_in_time_recovery {
+    enabled = true
+  }
+
   tags {
     Name        = "dynamodb-table-1"
     Environment = "test"
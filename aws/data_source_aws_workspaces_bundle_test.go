@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsWorkspacesBundle_basic(t *testing.T) {
+	dataSourceName := "data.aws_workspaces_bundle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsWorkspacesBundleConfig_bundleID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bundle_id", "wsb-bh8rsxt14"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Value with Windows 10"),
+					resource.TestCheckResourceAttr(dataSourceName, "owner", "Amazon"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_type.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_type.0.name", "VALUE"),
+					resource.TestCheckResourceAttr(dataSourceName, "root_storage.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_storage.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
+				),
+			},
+			{
+				Config: testAccDataSourceAwsWorkspacesBundleConfig_name,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bundle_id", "wsb-bh8rsxt14"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Value with Windows 10"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsWorkspacesBundleConfig_bundleID = `
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-bh8rsxt14"
+}
+`
+
+const testAccDataSourceAwsWorkspacesBundleConfig_name = `
+data "aws_workspaces_bundle" "test" {
+  name  = "Value with Windows 10"
+  owner = "AMAZON"
+}
+`
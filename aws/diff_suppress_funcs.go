@@ -85,3 +85,9 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// suppressMissingOptionalConfigurationBlock suppresses the removal of an
+// optional configuration block that the API populates with defaults.
+func suppressMissingOptionalConfigurationBlock(k, old, new string, d *schema.ResourceData) bool {
+	return strings.HasSuffix(k, ".#") && old == "1" && new == "0"
+}
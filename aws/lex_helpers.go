@@ -0,0 +1,282 @@
+package aws
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// LexVersionLatest is the alias of the draft version of Lex bots,
+// intents and slot types.
+const LexVersionLatest = "$LATEST"
+
+var lexNameRegex = regexp.MustCompile(`^([A-Za-z]_?)+$`)
+
+var lexVersionRegex = regexp.MustCompile(`^\$LATEST$|^[0-9]+$`)
+
+func validateLexName(minLength, maxLength int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		ws, errors = validation.StringLenBetween(minLength, maxLength)(v, k)
+		if len(errors) > 0 {
+			return
+		}
+		return validation.StringMatch(lexNameRegex, "must contain only letters, optionally separated by single underscores")(v, k)
+	}
+}
+
+func validateLexVersion(v interface{}, k string) (ws []string, errors []error) {
+	return validation.StringMatch(lexVersionRegex, "must be $LATEST or a version number")(v, k)
+}
+
+var lexMessageResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"content": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"content_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.ContentTypeCustomPayload,
+				lexmodelbuildingservice.ContentTypePlainText,
+				lexmodelbuildingservice.ContentTypeSsml,
+			}, false),
+		},
+		"group_number": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+	},
+}
+
+var lexStatementResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexPromptResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"max_attempts": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexCodeHookResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message_version": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 5),
+		},
+		"uri": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+// getLatestLexVersion returns the highest numbered version from a list of
+// versions, or $LATEST if no numbered version has been published yet.
+func getLatestLexVersion(versions []string) string {
+	latest := 0
+	for _, v := range versions {
+		if v == LexVersionLatest {
+			continue
+		}
+		if n, err := strconv.Atoi(v); err == nil && n > latest {
+			latest = n
+		}
+	}
+
+	if latest == 0 {
+		return LexVersionLatest
+	}
+	return strconv.Itoa(latest)
+}
+
+func expandLexMessages(rawValues []interface{}) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(value["content"].(string)),
+			ContentType: aws.String(value["content_type"].(string)),
+		}
+
+		if v, ok := value["group_number"].(int); ok && v != 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		m := map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+		}
+
+		if message.GroupNumber != nil {
+			m["group_number"] = int(aws.Int64Value(message.GroupNumber))
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandLexStatement(l []interface{}) *lexmodelbuildingservice.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []map[string]interface{} {
+	if statement == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message": flattenLexMessages(statement.Messages),
+	}
+
+	if statement.ResponseCard != nil {
+		m["response_card"] = aws.StringValue(statement.ResponseCard)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandLexPrompt(l []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(m["max_attempts"].(int))),
+		Messages:    expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []map[string]interface{} {
+	if prompt == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_attempts": int(aws.Int64Value(prompt.MaxAttempts)),
+		"message":      flattenLexMessages(prompt.Messages),
+	}
+
+	if prompt.ResponseCard != nil {
+		m["response_card"] = aws.StringValue(prompt.ResponseCard)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandLexCodeHook(l []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []map[string]interface{} {
+	if codeHook == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message_version": aws.StringValue(codeHook.MessageVersion),
+		"uri":             aws.StringValue(codeHook.Uri),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+// lexSetNewComputedVersion marks the computed version as unknown when an
+// update of any of the given keys will publish a new numbered version.
+func lexSetNewComputedVersion(diff *schema.ResourceDiff, keys []string) error {
+	if diff.Id() == "" || !diff.Get("create_version").(bool) {
+		return nil
+	}
+
+	for _, key := range append(keys, "create_version") {
+		if diff.HasChange(key) {
+			return diff.SetNewComputed("version")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestGetLatestLexVersion(t *testing.T) {
+	cases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: nil,
+			Expected: LexVersionLatest,
+		},
+		{
+			Versions: []string{LexVersionLatest},
+			Expected: LexVersionLatest,
+		},
+		{
+			Versions: []string{LexVersionLatest, "1", "2"},
+			Expected: "2",
+		},
+		{
+			Versions: []string{"10", LexVersionLatest, "9"},
+			Expected: "10",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := getLatestLexVersion(tc.Versions); got != tc.Expected {
+			t.Fatalf("getLatestLexVersion(%v): expected %q, got %q", tc.Versions, tc.Expected, got)
+		}
+	}
+}
+
+func TestValidateLexName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"OrderFlowers",
+		"order_flowers",
+		"Order_Flowers_Bot",
+	}
+	for _, v := range validNames {
+		if _, errors := validateLexName(1, 100)(v, "name"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lex name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"_order",
+		"order__flowers",
+		"order-flowers",
+		"order1",
+		"abcdefghijk",
+	}
+	for _, v := range invalidNames {
+		if _, errors := validateLexName(1, 10)(v, "name"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lex name", v)
+		}
+	}
+}
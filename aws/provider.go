@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +20,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +45,10 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,6 +81,8 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": retrySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -171,6 +179,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_canonical_user_id":                dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_stack":             dataSourceAwsCloudFormationStack(),
 			"aws_cloudtrail_service_account":       dataSourceAwsCloudTrailServiceAccount(),
+			"aws_db_cluster_snapshot":              dataSourceAwsDbClusterSnapshot(),
 			"aws_db_instance":                      dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
 			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
@@ -209,6 +218,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                          dataSourceAwsKmsKey(),
 			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
+			"aws_launch_template":                  dataSourceAwsLaunchTemplate(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_network_interface":                dataSourceAwsNetworkInterface(),
 			"aws_partition":                        dataSourceAwsPartition(),
@@ -230,6 +240,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
 			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
 			"aws_vpn_gateway":                      dataSourceAwsVpnGateway(),
+			"aws_workspaces_bundle":                dataSourceAwsWorkspacesBundle(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -241,339 +252,378 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
-			"aws_ami":                                      resourceAwsAmi(),
-			"aws_ami_copy":                                 resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                    resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                      resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                      resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                   resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":            resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":           resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                   resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_documentation_part":           resourceAwsApiGatewayDocumentationPart(),
-			"aws_api_gateway_documentation_version":        resourceAwsApiGatewayDocumentationVersion(),
-			"aws_api_gateway_domain_name":                  resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":             resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                  resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":         resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                       resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":              resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":              resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                        resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":            resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                     resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                     resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                     resourceAwsApiGatewayVpcLink(),
-			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
-			"aws_appautoscaling_scheduled_action":          resourceAwsAppautoscalingScheduledAction(),
-			"aws_appsync_datasource":                       resourceAwsAppsyncDatasource(),
-			"aws_appsync_graphql_api":                      resourceAwsAppsyncGraphqlApi(),
-			"aws_athena_database":                          resourceAwsAthenaDatabase(),
-			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
-			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
-			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
-			"aws_cloud9_environment_ec2":                   resourceAwsCloud9EnvironmentEc2(),
-			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudtrail":                               resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_permission":              resourceAwsCloudWatchEventPermission(),
-			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":        resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                     resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":             resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":           resourceAwsCloudWatchLogResourcePolicy(),
-			"aws_cloudwatch_log_stream":                    resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":       resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
-			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
-			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
-			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
-			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
-			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
-			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
-			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":             resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
-			"aws_codepipeline":                             resourceAwsCodePipeline(),
-			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
-			"aws_dax_cluster":                              resourceAwsDaxCluster(),
-			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
-			"aws_db_instance":                              resourceAwsDbInstance(),
-			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                              resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                       resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
-			"aws_dms_certificate":                          resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
-			"aws_dx_lag":                                   resourceAwsDxLag(),
-			"aws_dx_connection":                            resourceAwsDxConnection(),
-			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
-			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
-			"aws_dynamodb_table_item":                      resourceAwsDynamoDbTableItem(),
-			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
-			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
-			"aws_ebs_volume":                               resourceAwsEbsVolume(),
-			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
-			"aws_ecr_repository":                           resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
-			"aws_ecs_service":                              resourceAwsEcsService(),
-			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                          resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                         resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                      resourceAwsEip(),
-			"aws_eip_association":                          resourceAwsEipAssociation(),
-			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":               resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                 resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":            resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":    resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template": resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":            resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                     resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":              resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":               resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                 resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                      resourceAwsElb(),
-			"aws_elb_attachment":                           resourceAwsElbAttachment(),
-			"aws_emr_cluster":                              resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                 resourceAwsFlowLog(),
-			"aws_gamelift_alias":                           resourceAwsGameliftAlias(),
-			"aws_gamelift_build":                           resourceAwsGameliftBuild(),
-			"aws_gamelift_fleet":                           resourceAwsGameliftFleet(),
-			"aws_glacier_vault":                            resourceAwsGlacierVault(),
-			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
-			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
-			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
-			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
-			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
-			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                resourceAwsIamGroup(),
-			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                               resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                          resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                 resourceAwsIamRole(),
-			"aws_iam_saml_provider":                        resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                   resourceAwsIAMServerCertificate(),
-			"aws_iam_user_policy_attachment":               resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                          resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                         resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                 resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                   resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":              resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":            resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                 resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                 resourceAwsInstance(),
-			"aws_internet_gateway":                         resourceAwsInternetGateway(),
-			"aws_iot_certificate":                          resourceAwsIotCertificate(),
-			"aws_iot_policy":                               resourceAwsIotPolicy(),
-			"aws_iot_thing":                                resourceAwsIotThing(),
-			"aws_iot_thing_type":                           resourceAwsIotThingType(),
-			"aws_iot_topic_rule":                           resourceAwsIotTopicRule(),
-			"aws_key_pair":                                 resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
-			"aws_kms_alias":                                resourceAwsKmsAlias(),
-			"aws_kms_grant":                                resourceAwsKmsGrant(),
-			"aws_kms_key":                                  resourceAwsKmsKey(),
-			"aws_lambda_function":                          resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
-			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                resourceAwsLBSSLNegotiationPolicy(),
-			"aws_main_route_table_association":             resourceAwsMainRouteTableAssociation(),
-			"aws_mq_broker":                                resourceAwsMqBroker(),
-			"aws_mq_configuration":                         resourceAwsMqConfiguration(),
-			"aws_media_store_container":                    resourceAwsMediaStoreContainer(),
-			"aws_nat_gateway":                              resourceAwsNatGateway(),
-			"aws_network_acl":                              resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                      resourceAwsDefaultNetworkAcl(),
-			"aws_network_acl_rule":                         resourceAwsNetworkAclRule(),
-			"aws_network_interface":                        resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":             resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                     resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                           resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                  resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                   resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                   resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                 resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                 resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                     resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                   resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                    resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                 resourceAwsOpsworksRdsDbInstance(),
-			"aws_organizations_organization":               resourceAwsOrganizationsOrganization(),
-			"aws_placement_group":                          resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                              resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                     resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":              resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                         resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                  resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                 resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
-			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
-			"aws_route53_query_log":                        resourceAwsRoute53QueryLog(),
-			"aws_route53_record":                           resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                             resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
-			"aws_route":                                    resourceAwsRoute(),
-			"aws_route_table":                              resourceAwsRouteTable(),
-			"aws_default_route_table":                      resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
-			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
-			"aws_ses_domain_dkim":                          resourceAwsSesDomainDkim(),
-			"aws_ses_domain_mail_from":                     resourceAwsSesDomainMailFrom(),
-			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                     resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                    resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                    resourceAwsSesEventDestination(),
-			"aws_ses_template":                             resourceAwsSesTemplate(),
-			"aws_s3_bucket":                                resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
-			"aws_s3_bucket_metric":                         resourceAwsS3BucketMetric(),
-			"aws_security_group":                           resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
-			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
-			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
-			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
-			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
-			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                           resourceAwsSsmActivation(),
-			"aws_ssm_association":                          resourceAwsSsmAssociation(),
-			"aws_ssm_document":                             resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                   resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":            resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":              resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                       resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                          resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                            resourceAwsSsmParameter(),
-			"aws_ssm_resource_data_sync":                   resourceAwsSsmResourceDataSync(),
-			"aws_spot_datafeed_subscription":               resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                    resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                       resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                 resourceAwsSnsPlatformApplication(),
-			"aws_sns_topic":                                resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                             resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                           resourceAwsDefaultSubnet(),
-			"aws_subnet":                                   resourceAwsSubnet(),
-			"aws_volume_attachment":                        resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                 resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_default_vpc":                              resourceAwsDefaultVpc(),
-			"aws_vpc":                                      resourceAwsVpc(),
-			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_connection_notification":     resourceAwsVpcEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":          resourceAwsVpcEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
-			"aws_vpn_connection":                           resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                resourceAwsWafIPSet(),
-			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
-			"aws_waf_regex_match_set":                      resourceAwsWafRegexMatchSet(),
-			"aws_waf_regex_pattern_set":                    resourceAwsWafRegexPatternSet(),
-			"aws_waf_rule":                                 resourceAwsWafRule(),
-			"aws_waf_rule_group":                           resourceAwsWafRuleGroup(),
-			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
-			"aws_waf_geo_match_set":                        resourceAwsWafGeoMatchSet(),
-			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_geo_match_set":                resourceAwsWafRegionalGeoMatchSet(),
-			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
-			"aws_wafregional_rate_based_rule":              resourceAwsWafRegionalRateBasedRule(),
-			"aws_wafregional_regex_match_set":              resourceAwsWafRegionalRegexMatchSet(),
-			"aws_wafregional_regex_pattern_set":            resourceAwsWafRegionalRegexPatternSet(),
-			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
-			"aws_wafregional_rule_group":                   resourceAwsWafRegionalRuleGroup(),
-			"aws_wafregional_size_constraint_set":          resourceAwsWafRegionalSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":      resourceAwsWafRegionalSqlInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                resourceAwsWafRegionalXssMatchSet(),
-			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
-			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
-			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
-			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
+			"aws_acm_certificate":                                resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":                     resourceAwsAcmCertificateValidation(),
+			"aws_ami":                                            resourceAwsAmi(),
+			"aws_ami_copy":                                       resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                              resourceAwsAmiFromInstance(),
+			"aws_ami_launch_permission":                          resourceAwsAmiLaunchPermission(),
+			"aws_api_gateway_account":                            resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                            resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                         resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                  resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                 resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                         resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_documentation_part":                 resourceAwsApiGatewayDocumentationPart(),
+			"aws_api_gateway_documentation_version":              resourceAwsApiGatewayDocumentationVersion(),
+			"aws_api_gateway_domain_name":                        resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                   resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                        resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":               resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                             resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                    resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                    resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                              resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                  resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                           resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                           resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                              resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                         resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                     resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                           resourceAwsApiGatewayVpcLink(),
+			"aws_app_cookie_stickiness_policy":                   resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                          resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                          resourceAwsAppautoscalingPolicy(),
+			"aws_appautoscaling_scheduled_action":                resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_datasource":                             resourceAwsAppsyncDatasource(),
+			"aws_appsync_graphql_api":                            resourceAwsAppsyncGraphqlApi(),
+			"aws_athena_database":                                resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                             resourceAwsAthenaNamedQuery(),
+			"aws_autoscaling_attachment":                         resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                              resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_lifecycle_hook":                     resourceAwsAutoscalingLifecycleHook(),
+			"aws_autoscaling_notification":                       resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                             resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                           resourceAwsAutoscalingSchedule(),
+			"aws_cloud9_environment_ec2":                         resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloudformation_stack":                           resourceAwsCloudFormationStack(),
+			"aws_cloudfront_distribution":                        resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":              resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudsearch_domain":                             resourceAwsCloudSearchDomain(),
+			"aws_cloudtrail":                                     resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_permission":                    resourceAwsCloudWatchEventPermission(),
+			"aws_cloudwatch_event_rule":                          resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                        resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                     resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":              resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                           resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                   resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":                 resourceAwsCloudWatchLogResourcePolicy(),
+			"aws_cloudwatch_log_stream":                          resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":             resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_config_rule":                             resourceAwsConfigConfigRule(),
+			"aws_config_configuration_recorder":                  resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":           resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                        resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_pool":                          resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":         resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_user_group":                             resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                              resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                       resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                       resourceAwsCognitoUserPoolDomain(),
+			"aws_cloudwatch_metric_alarm":                        resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_dashboard":                           resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                 resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                   resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                    resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                          resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                             resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                              resourceAwsCodeBuildProject(),
+			"aws_codepipeline":                                   resourceAwsCodePipeline(),
+			"aws_customer_gateway":                               resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                                    resourceAwsDaxCluster(),
+			"aws_db_event_subscription":                          resourceAwsDbEventSubscription(),
+			"aws_db_cluster_snapshot":                            resourceAwsDbClusterSnapshot(),
+			"aws_db_instance":                                    resourceAwsDbInstance(),
+			"aws_db_option_group":                                resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                             resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                              resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                    resourceAwsDbSnapshot(),
+			"aws_db_subnet_group":                                resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                             resourceAwsDevicefarmProject(),
+			"aws_directory_service_directory":                    resourceAwsDirectoryServiceDirectory(),
+			"aws_dms_certificate":                                resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                   resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                       resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                   resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                           resourceAwsDmsReplicationTask(),
+			"aws_dx_lag":                                         resourceAwsDxLag(),
+			"aws_dx_connection":                                  resourceAwsDxConnection(),
+			"aws_dx_connection_association":                      resourceAwsDxConnectionAssociation(),
+			"aws_dynamodb_table":                                 resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_item":                            resourceAwsDynamoDbTableItem(),
+			"aws_dynamodb_global_table":                          resourceAwsDynamoDbGlobalTable(),
+			"aws_ebs_snapshot":                                   resourceAwsEbsSnapshot(),
+			"aws_ebs_volume":                                     resourceAwsEbsVolume(),
+			"aws_ecr_lifecycle_policy":                           resourceAwsEcrLifecyclePolicy(),
+			"aws_ecr_repository":                                 resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                          resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                    resourceAwsEcsCluster(),
+			"aws_ecs_service":                                    resourceAwsEcsService(),
+			"aws_ecs_task_definition":                            resourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                                resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                               resourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":                   resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                            resourceAwsEip(),
+			"aws_eip_association":                                resourceAwsEipAssociation(),
+			"aws_elasticache_cluster":                            resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                    resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                  resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                     resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                       resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                  resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":          resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":       resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                  resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                           resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                    resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                     resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                       resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                            resourceAwsElb(),
+			"aws_elb_attachment":                                 resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                    resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                             resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                     resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                       resourceAwsFlowLog(),
+			"aws_gamelift_alias":                                 resourceAwsGameliftAlias(),
+			"aws_gamelift_build":                                 resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                                 resourceAwsGameliftFleet(),
+			"aws_glacier_vault":                                  resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                          resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                             resourceAwsGlueCatalogTable(),
+			"aws_glue_classifier":                                resourceAwsGlueClassifier(),
+			"aws_glue_connection":                                resourceAwsGlueConnection(),
+			"aws_glue_crawler":                                   resourceAwsGlueCrawler(),
+			"aws_glue_job":                                       resourceAwsGlueJob(),
+			"aws_glue_trigger":                                   resourceAwsGlueTrigger(),
+			"aws_guardduty_detector":                             resourceAwsGuardDutyDetector(),
+			"aws_guardduty_ipset":                                resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                               resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                       resourceAwsGuardDutyThreatintelset(),
+			"aws_iam_access_key":                                 resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                              resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                    resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                               resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                      resourceAwsIamGroup(),
+			"aws_iam_group_membership":                           resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                    resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                           resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                    resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                     resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                          resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                     resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                       resourceAwsIamRole(),
+			"aws_iam_saml_provider":                              resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                         resourceAwsIAMServerCertificate(),
+			"aws_iam_user_policy_attachment":                     resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                               resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                       resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                         resourceAwsIamUserLoginProfile(),
+			"aws_inspector_assessment_target":                    resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                  resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                       resourceAWSInspectorResourceGroup(),
+			"aws_instance":                                       resourceAwsInstance(),
+			"aws_internet_gateway":                               resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                resourceAwsIotCertificate(),
+			"aws_iot_policy":                                     resourceAwsIotPolicy(),
+			"aws_iot_policy_attachment":                          resourceAwsIotPolicyAttachment(),
+			"aws_iot_role_alias":                                 resourceAwsIotRoleAlias(),
+			"aws_iot_thing":                                      resourceAwsIotThing(),
+			"aws_iot_thing_group":                                resourceAwsIotThingGroup(),
+			"aws_iot_thing_principal_attachment":                 resourceAwsIotThingPrincipalAttachment(),
+			"aws_iot_thing_type":                                 resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                                 resourceAwsIotTopicRule(),
+			"aws_key_pair":                                       resourceAwsKeyPair(),
+			"aws_kinesis_firehose_delivery_stream":               resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                 resourceAwsKinesisStream(),
+			"aws_kms_alias":                                      resourceAwsKmsAlias(),
+			"aws_kms_grant":                                      resourceAwsKmsGrant(),
+			"aws_kms_key":                                        resourceAwsKmsKey(),
+			"aws_lambda_function":                                resourceAwsLambdaFunction(),
+			"aws_lambda_event_source_mapping":                    resourceAwsLambdaEventSourceMapping(),
+			"aws_lambda_alias":                                   resourceAwsLambdaAlias(),
+			"aws_lambda_permission":                              resourceAwsLambdaPermission(),
+			"aws_launch_configuration":                           resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                                resourceAwsLaunchTemplate(),
+			"aws_lex_bot":                                        resourceAwsLexBot(),
+			"aws_lex_bot_alias":                                  resourceAwsLexBotAlias(),
+			"aws_lex_intent":                                     resourceAwsLexIntent(),
+			"aws_lex_slot_type":                                  resourceAwsLexSlotType(),
+			"aws_lightsail_domain":                               resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                             resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                             resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                            resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                 resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                    resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                           resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":            resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                  resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                      resourceAwsLBSSLNegotiationPolicy(),
+			"aws_main_route_table_association":                   resourceAwsMainRouteTableAssociation(),
+			"aws_mq_broker":                                      resourceAwsMqBroker(),
+			"aws_mq_configuration":                               resourceAwsMqConfiguration(),
+			"aws_media_package_channel":                          resourceAwsMediaPackageChannel(),
+			"aws_media_store_container":                          resourceAwsMediaStoreContainer(),
+			"aws_medialive_input_security_group":                 resourceAwsMediaLiveInputSecurityGroup(),
+			"aws_nat_gateway":                                    resourceAwsNatGateway(),
+			"aws_network_acl":                                    resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                            resourceAwsDefaultNetworkAcl(),
+			"aws_network_acl_rule":                               resourceAwsNetworkAclRule(),
+			"aws_network_interface":                              resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                   resourceAwsNetworkInterfaceAttachment(),
+			"aws_opsworks_application":                           resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                 resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                        resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                         resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                      resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                         resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                       resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                      resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                       resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                           resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                         resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                          resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                              resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                          resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                            resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                       resourceAwsOpsworksRdsDbInstance(),
+			"aws_organizations_account":                          resourceAwsOrganizationsAccount(),
+			"aws_organizations_organization":                     resourceAwsOrganizationsOrganization(),
+			"aws_organizations_organizational_unit":              resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_policy":                           resourceAwsOrganizationsPolicy(),
+			"aws_organizations_policy_attachment":                resourceAwsOrganizationsPolicyAttachment(),
+			"aws_placement_group":                                resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                          resourceAwsProxyProtocolPolicy(),
+			"aws_rds_cluster":                                    resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                           resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                    resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                               resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                        resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                       resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                          resourceAwsRedshiftSubnetGroup(),
+			"aws_route53_delegation_set":                         resourceAwsRoute53DelegationSet(),
+			"aws_route53_query_log":                              resourceAwsRoute53QueryLog(),
+			"aws_route53_record":                                 resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                       resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                   resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                           resourceAwsRoute53HealthCheck(),
+			"aws_route":                                          resourceAwsRoute(),
+			"aws_route_table":                                    resourceAwsRouteTable(),
+			"aws_default_route_table":                            resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                        resourceAwsRouteTableAssociation(),
+			"aws_sagemaker_endpoint":                             resourceAwsSagemakerEndpoint(),
+			"aws_sagemaker_endpoint_configuration":               resourceAwsSagemakerEndpointConfiguration(),
+			"aws_sagemaker_model":                                resourceAwsSagemakerModel(),
+			"aws_sagemaker_notebook_instance":                    resourceAwsSagemakerNotebookInstance(),
+			"aws_ses_active_receipt_rule_set":                    resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                            resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_dkim":                                resourceAwsSesDomainDkim(),
+			"aws_ses_domain_mail_from":                           resourceAwsSesDomainMailFrom(),
+			"aws_ses_receipt_filter":                             resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                               resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                           resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                          resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                          resourceAwsSesEventDestination(),
+			"aws_ses_template":                                   resourceAwsSesTemplate(),
+			"aws_s3_bucket":                                      resourceAwsS3Bucket(),
+			"aws_s3_bucket_analytics_configuration":              resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_policy":                               resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object":                               resourceAwsS3BucketObject(),
+			"aws_s3_bucket_notification":                         resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                               resourceAwsS3BucketMetric(),
+			"aws_s3_bucket_inventory":                            resourceAwsS3BucketInventory(),
+			"aws_security_group":                                 resourceAwsSecurityGroup(),
+			"aws_network_interface_sg_attachment":                resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                         resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                            resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_launch_constraint":               resourceAwsServiceCatalogLaunchConstraint(),
+			"aws_servicecatalog_portfolio":                       resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":                 resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_principal_portfolio_association": resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                         resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":   resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioning_artifact":           resourceAwsServiceCatalogProvisioningArtifact(),
+			"aws_servicecatalog_tag_option":                      resourceAwsServiceCatalogTagOption(),
+			"aws_service_discovery_private_dns_namespace":        resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":         resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                      resourceAwsServiceDiscoveryService(),
+			"aws_simpledb_domain":                                resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                 resourceAwsSsmActivation(),
+			"aws_ssm_association":                                resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                   resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                         resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                  resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                    resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                             resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                  resourceAwsSsmParameter(),
+			"aws_ssm_resource_data_sync":                         resourceAwsSsmResourceDataSync(),
+			"aws_spot_datafeed_subscription":                     resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                          resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                             resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                      resourceAwsSqsQueue(),
+			"aws_sqs_queue_policy":                               resourceAwsSqsQueuePolicy(),
+			"aws_snapshot_create_volume_permission":              resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                       resourceAwsSnsPlatformApplication(),
+			"aws_sns_topic":                                      resourceAwsSnsTopic(),
+			"aws_sns_topic_policy":                               resourceAwsSnsTopicPolicy(),
+			"aws_sns_topic_subscription":                         resourceAwsSnsTopicSubscription(),
+			"aws_sfn_activity":                                   resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                              resourceAwsSfnStateMachine(),
+			"aws_swf_domain":                                     resourceAwsSwfDomain(),
+			"aws_default_subnet":                                 resourceAwsDefaultSubnet(),
+			"aws_subnet":                                         resourceAwsSubnet(),
+			"aws_volume_attachment":                              resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                   resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                       resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                               resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                         resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_default_vpc":                                    resourceAwsDefaultVpc(),
+			"aws_vpc":                                            resourceAwsVpc(),
+			"aws_vpc_endpoint":                                   resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":           resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":           resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                           resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":         resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpn_connection":                                 resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                           resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                    resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                         resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                  resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                             resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                      resourceAwsWafIPSet(),
+			"aws_waf_rate_based_rule":                            resourceAwsWafRateBasedRule(),
+			"aws_waf_regex_match_set":                            resourceAwsWafRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                          resourceAwsWafRegexPatternSet(),
+			"aws_waf_rule":                                       resourceAwsWafRule(),
+			"aws_waf_rule_group":                                 resourceAwsWafRuleGroup(),
+			"aws_waf_size_constraint_set":                        resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                    resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                              resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                    resourceAwsWafSqlInjectionMatchSet(),
+			"aws_waf_geo_match_set":                              resourceAwsWafGeoMatchSet(),
+			"aws_wafregional_byte_match_set":                     resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_geo_match_set":                      resourceAwsWafRegionalGeoMatchSet(),
+			"aws_wafregional_ipset":                              resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rate_based_rule":                    resourceAwsWafRegionalRateBasedRule(),
+			"aws_wafregional_regex_match_set":                    resourceAwsWafRegionalRegexMatchSet(),
+			"aws_wafregional_regex_pattern_set":                  resourceAwsWafRegionalRegexPatternSet(),
+			"aws_wafregional_rule":                               resourceAwsWafRegionalRule(),
+			"aws_wafregional_rule_group":                         resourceAwsWafRegionalRuleGroup(),
+			"aws_wafregional_size_constraint_set":                resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":            resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_xss_match_set":                      resourceAwsWafRegionalXssMatchSet(),
+			"aws_wafregional_web_acl":                            resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":                resourceAwsWafRegionalWebAclAssociation(),
+			"aws_workspaces_workspace":                           resourceAwsWorkspacesWorkspace(),
+			"aws_batch_compute_environment":                      resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                           resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                resourceAwsBatchJobQueue(),
+			"aws_budgets_budget":                                 resourceAwsBudgetsBudget(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
@@ -594,6 +644,43 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	// The default tags are only known once this provider is configured, so
+	// bind the tags diff suppression to its meta
+	defaultTagsConfig := func() *DefaultTagsConfig {
+		if client, ok := provider.Meta().(*AWSClient); ok {
+			return client.defaultTagsConfig
+		}
+		return nil
+	}
+	for _, r := range provider.ResourcesMap {
+		if s, ok := r.Schema["tags"]; ok && s.DiffSuppressFunc != nil {
+			s.DiffSuppressFunc = suppressDefaultTagsDiff(defaultTagsConfig)
+		}
+	}
+
+	// Keep the tags ignored in ignore_tags out of the state
+	for _, r := range provider.ResourcesMap {
+		if !hasIgnoredTagsAttributes(r) {
+			continue
+		}
+		if r.Create != nil {
+			r.Create = ignoreTagsFunc(r.Create)
+		}
+		if r.Read != nil {
+			r.Read = ignoreTagsFunc(r.Read)
+		}
+		if r.Update != nil {
+			r.Update = ignoreTagsFunc(r.Update)
+		}
+	}
+	for _, r := range provider.DataSourcesMap {
+		if hasIgnoredTagsAttributes(r) && r.Read != nil {
+			r.Read = ignoreTagsFunc(r.Read)
+		}
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -694,6 +781,25 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on a" +
+			" resource take precedence over these.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
+		"retry_service": "The SDK endpoint prefix of the service the policy applies to, e.g. `ec2`." +
+			" Omit to set the default policy for all services.",
+
+		"retry_max_attempts": "The maximum number of attempts, including the first, of a single" +
+			" API request.",
+
+		"retry_backoff_base": "The delay before the first retry, doubled on each further retry.",
+
+		"retry_backoff_cap": "The maximum delay between retries.",
+
+		"retry_retryable_error_codes": "Additional API error codes that are retried.",
 	}
 }
 
@@ -738,6 +844,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if l := d.Get("default_tags").([]interface{}); len(l) > 0 && l[0] != nil {
+		defaultTags := l[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	if l := d.Get("ignore_tags").([]interface{}); len(l) > 0 && l[0] != nil {
+		ignoreTags := l[0].(map[string]interface{})
+		for _, k := range ignoreTags["keys"].(*schema.Set).List() {
+			config.IgnoreTagsKeys = append(config.IgnoreTagsKeys, k.(string))
+		}
+		for _, p := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			config.IgnoreTagsKeyPrefixes = append(config.IgnoreTagsKeyPrefixes, p.(string))
+		}
+	}
+
+	retryConfig, err := expandProviderRetryConfig(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	config.Retry = retryConfig
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -816,6 +943,140 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["retry_service"],
+				},
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_max_attempts"],
+				},
+				"backoff_base": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateRetryDuration,
+					Description:  descriptions["retry_backoff_base"],
+				},
+				"backoff_cap": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateRetryDuration,
+					Description:  descriptions["retry_backoff_cap"],
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["retry_retryable_error_codes"],
+				},
+			},
+		},
+	}
+}
+
+func expandProviderRetryConfig(l []interface{}) (RetryConfig, error) {
+	config := RetryConfig{
+		Services: make(map[string]RetryPolicy),
+	}
+	hasDefault := false
+
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+
+		policy := RetryPolicy{
+			MaxAttempts: m["max_attempts"].(int),
+		}
+		for _, code := range m["retryable_error_codes"].(*schema.Set).List() {
+			policy.RetryableErrorCodes = append(policy.RetryableErrorCodes, code.(string))
+		}
+		for k, v := range map[string]*time.Duration{
+			"backoff_base": &policy.BackoffBase,
+			"backoff_cap":  &policy.BackoffCap,
+		} {
+			if s := m[k].(string); s != "" {
+				duration, err := time.ParseDuration(s)
+				if err != nil {
+					return config, fmt.Errorf("Error parsing retry %s: %s", k, err)
+				}
+				*v = duration
+			}
+		}
+
+		service := m["service"].(string)
+		if service == "" {
+			if hasDefault {
+				return config, fmt.Errorf("Only one retry block may omit service")
+			}
+			hasDefault = true
+			config.Default = policy
+			continue
+		}
+
+		if _, ok := config.Services[service]; ok {
+			return config, fmt.Errorf("Duplicate retry block for service %q", service)
+		}
+		config.Services[service] = policy
+	}
+
+	return config, nil
+}
+
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
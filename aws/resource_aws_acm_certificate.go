@@ -97,10 +97,10 @@ func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
-			Tags:           TagsFromMapACM(v.(map[string]interface{})),
+			Tags:           TagsFromMapACM(v),
 		}
 		_, err := acmconn.AddTagsToCertificate(params)
 
@@ -178,7 +178,7 @@ func resourceAwsAcmCertificateGuessValidationMethod(domainValidationOptions []ma
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags") {
 		acmconn := meta.(*AWSClient).acmconn
-		err := SetTagsACM(acmconn, d)
+		err := SetTagsACM(acmconn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig)
 		if err != nil {
 			return err
 		}
@@ -239,7 +239,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := SetTags(client, d); err != nil {
+	if err := SetTags(client, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
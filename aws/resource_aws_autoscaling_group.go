@@ -48,8 +48,38 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
+			},
+
+			"launch_template": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"launch_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "$Default",
+							ValidateFunc: validateLaunchTemplateVersion,
+						},
+					},
+				},
 			},
 
 			"desired_capacity": {
@@ -310,9 +340,16 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
+
+	if v, ok := d.GetOk("launch_configuration"); ok {
+		createOpts.LaunchConfigurationName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template"); ok {
+		createOpts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+	} else {
+		return fmt.Errorf("One of `launch_configuration` or `launch_template` must be set for an autoscaling group")
+	}
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
@@ -466,6 +503,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenAutoScalingLaunchTemplateSpecification(g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("Error setting launch_template for %q: %s", d.Id(), err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -572,7 +612,15 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			opts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+		}
 	}
 
 	if d.HasChange("min_size") {
@@ -622,7 +670,7 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		opts.ServiceLinkedRoleARN = aws.String(d.Get("service_linked_role_arn").(string))
 	}
 
-	if err := setAutoscalingTags(conn, d); err != nil {
+	if err := setAutoscalingTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -1045,3 +1093,45 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+func expandAutoScalingLaunchTemplateSpecification(l []interface{}) *autoscaling.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &autoscaling.LaunchTemplateSpecification{}
+
+	if v, ok := m["id"].(string); ok && v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	}
+	if v, ok := m["name"].(string); ok && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+	if v, ok := m["version"].(string); ok && v != "" {
+		spec.Version = aws.String(v)
+	}
+
+	return spec
+}
+
+func flattenAutoScalingLaunchTemplateSpecification(spec *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	// The version is echoed back as configured, so $Latest and $Default
+	// are kept as such rather than resolved to a number.
+	version := aws.StringValue(spec.Version)
+	if version == "" {
+		version = "$Default"
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":      aws.StringValue(spec.LaunchTemplateId),
+			"name":    aws.StringValue(spec.LaunchTemplateName),
+			"version": version,
+		},
+	}
+}
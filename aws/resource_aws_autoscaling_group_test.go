@@ -184,6 +184,40 @@ func TestAccAWSAutoScalingGroup_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate("$Latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttrSet(
+						"aws_autoscaling_group.bar", "launch_template.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"aws_autoscaling_group.bar", "launch_template.0.name"),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_template.0.version", "$Latest"),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate("$Default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_template.0.version", "$Default"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_autoGeneratedName(t *testing.T) {
 	asgNameRegexp := regexp.MustCompile("^tf-asg-")
 
@@ -2223,3 +2257,39 @@ resource "aws_launch_configuration" "test" {
   instance_type = "t2.micro"
 }
 `
+
+func testAccAWSAutoScalingGroupConfig_launchTemplate(version string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "foobar" {
+  name_prefix   = "foobar"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    id      = "${aws_launch_template.foobar.id}"
+    version = %q
+  }
+}
+`, version)
+}
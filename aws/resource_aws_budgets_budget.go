@@ -0,0 +1,649 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// budgetsTimePeriodLayout is the format used for time_period_start and time_period_end
+const budgetsTimePeriodLayout = "2006-01-02_15:04"
+
+func resourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetCreate,
+		Read:   resourceAwsBudgetsBudgetRead,
+		Update: resourceAwsBudgetsBudgetUpdate,
+		Delete: resourceAwsBudgetsBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"budget_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.BudgetTypeCost,
+					budgets.BudgetTypeRiUtilization,
+					budgets.BudgetTypeUsage,
+				}, false),
+			},
+			"cost_filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"cost_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_credit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_discount": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_other_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_recurring": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_refund": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_tax": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_upfront": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"use_amortized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_blended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"limit_amount": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentBudgetLimitAmount,
+			},
+			"limit_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  budgets.ThresholdTypePercentage,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+					},
+				},
+			},
+			"time_period_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"time_period_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.TimeUnitAnnually,
+					budgets.TimeUnitDaily,
+					budgets.TimeUnitMonthly,
+					budgets.TimeUnitQuarterly,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	budget, err := expandBudgetsBudget(d)
+	if err != nil {
+		return err
+	}
+
+	notifications, err := expandBudgetsNotificationsWithSubscribers(d.Get("notification").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetInput{
+		AccountId: aws.String(accountID),
+		Budget:    budget,
+	}
+	if len(notifications) > 0 {
+		input.NotificationsWithSubscribers = notifications
+	}
+
+	log.Printf("[DEBUG] Creating Budget: %s", input)
+	if _, err := conn.CreateBudget(input); err != nil {
+		return fmt.Errorf("Error creating Budget (%s): %s", aws.StringValue(budget.BudgetName), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accountID, aws.StringValue(budget.BudgetName)))
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Budget (%s): %s", d.Id(), err)
+	}
+
+	budget := resp.Budget
+	if budget == nil {
+		log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("name", budget.BudgetName)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	if budget.TimePeriod != nil {
+		d.Set("time_period_end", aws.TimeValue(budget.TimePeriod.End).UTC().Format(budgetsTimePeriodLayout))
+		d.Set("time_period_start", aws.TimeValue(budget.TimePeriod.Start).UTC().Format(budgetsTimePeriodLayout))
+	}
+
+	if err := d.Set("cost_filters", flattenBudgetsCostFilters(budget.CostFilters)); err != nil {
+		return fmt.Errorf("Error setting cost_filters: %s", err)
+	}
+
+	if err := d.Set("cost_types", flattenBudgetsCostTypes(budget.CostTypes)); err != nil {
+		return fmt.Errorf("Error setting cost_types: %s", err)
+	}
+
+	notifications, err := readBudgetsNotifications(conn, accountID, budgetName)
+	if err != nil {
+		return fmt.Errorf("Error reading Budget (%s) notifications: %s", d.Id(), err)
+	}
+
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("Error setting notification: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	budget, err := expandBudgetsBudget(d)
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.UpdateBudgetInput{
+		AccountId: aws.String(accountID),
+		NewBudget: budget,
+	}
+
+	log.Printf("[DEBUG] Updating Budget: %s", input)
+	if _, err := conn.UpdateBudget(input); err != nil {
+		return fmt.Errorf("Error updating Budget (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		removed, err := expandBudgetsNotificationsWithSubscribers(os.Difference(ns).List())
+		if err != nil {
+			return err
+		}
+
+		for _, notification := range removed {
+			log.Printf("[DEBUG] Deleting Budget (%s) notification: %s", d.Id(), notification.Notification)
+			_, err := conn.DeleteNotification(&budgets.DeleteNotificationInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(budgetName),
+				Notification: notification.Notification,
+			})
+			if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				return fmt.Errorf("Error deleting Budget (%s) notification: %s", d.Id(), err)
+			}
+		}
+
+		added, err := expandBudgetsNotificationsWithSubscribers(ns.Difference(os).List())
+		if err != nil {
+			return err
+		}
+
+		for _, notification := range added {
+			log.Printf("[DEBUG] Creating Budget (%s) notification: %s", d.Id(), notification.Notification)
+			_, err := conn.CreateNotification(&budgets.CreateNotificationInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(budgetName),
+				Notification: notification.Notification,
+				Subscribers:  notification.Subscribers,
+			})
+			if err != nil {
+				return fmt.Errorf("Error creating Budget (%s) notification: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Budget: %s", d.Id())
+	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Budget (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeBudgetsBudgetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected ACCOUNT_ID:BUDGET_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func readBudgetsNotifications(conn *budgets.Budgets, accountID, budgetName string) ([]map[string]interface{}, error) {
+	var notifications []*budgets.Notification
+
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	}
+	for {
+		resp, err := conn.DescribeNotificationsForBudget(input)
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				break
+			}
+			return nil, err
+		}
+
+		notifications = append(notifications, resp.Notifications...)
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		var subscribers []*budgets.Subscriber
+
+		subscribersInput := &budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+		}
+		for {
+			resp, err := conn.DescribeSubscribersForNotification(subscribersInput)
+			if err != nil {
+				if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+					break
+				}
+				return nil, err
+			}
+
+			subscribers = append(subscribers, resp.Subscribers...)
+
+			if aws.StringValue(resp.NextToken) == "" {
+				break
+			}
+			subscribersInput.NextToken = resp.NextToken
+		}
+
+		result = append(result, flattenBudgetsNotification(notification, subscribers))
+	}
+
+	return result, nil
+}
+
+func expandBudgetsBudget(d *schema.ResourceData) (*budgets.Budget, error) {
+	budget := &budgets.Budget{
+		BudgetLimit: &budgets.Spend{
+			Amount: aws.String(d.Get("limit_amount").(string)),
+			Unit:   aws.String(d.Get("limit_unit").(string)),
+		},
+		BudgetName:  aws.String(d.Get("name").(string)),
+		BudgetType:  aws.String(d.Get("budget_type").(string)),
+		CostFilters: expandBudgetsCostFilters(d.Get("cost_filters").(map[string]interface{})),
+		CostTypes:   expandBudgetsCostTypes(d.Get("cost_types").([]interface{})),
+		TimeUnit:    aws.String(d.Get("time_unit").(string)),
+	}
+
+	start, startOk := d.GetOk("time_period_start")
+	end, endOk := d.GetOk("time_period_end")
+	if startOk || endOk {
+		budget.TimePeriod = &budgets.TimePeriod{}
+
+		if startOk {
+			t, err := time.Parse(budgetsTimePeriodLayout, start.(string))
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing time_period_start: %s", err)
+			}
+			budget.TimePeriod.Start = aws.Time(t)
+		}
+
+		if endOk {
+			t, err := time.Parse(budgetsTimePeriodLayout, end.(string))
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing time_period_end: %s", err)
+			}
+			budget.TimePeriod.End = aws.Time(t)
+		}
+	}
+
+	return budget, nil
+}
+
+// expandBudgetsCostFilters splits each filter value on commas, so a single
+// filter can match several values, e.g. AZ = "us-east-1a,us-east-1b".
+func expandBudgetsCostFilters(m map[string]interface{}) map[string][]*string {
+	filters := make(map[string][]*string, len(m))
+
+	for k, v := range m {
+		var values []*string
+		for _, value := range strings.Split(v.(string), ",") {
+			values = append(values, aws.String(strings.TrimSpace(value)))
+		}
+		filters[k] = values
+	}
+
+	return filters
+}
+
+func flattenBudgetsCostFilters(filters map[string][]*string) map[string]interface{} {
+	m := make(map[string]interface{}, len(filters))
+
+	for k, v := range filters {
+		m[k] = strings.Join(aws.StringValueSlice(v), ",")
+	}
+
+	return m
+}
+
+func expandBudgetsCostTypes(l []interface{}) *budgets.CostTypes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &budgets.CostTypes{
+		IncludeCredit:            aws.Bool(m["include_credit"].(bool)),
+		IncludeDiscount:          aws.Bool(m["include_discount"].(bool)),
+		IncludeOtherSubscription: aws.Bool(m["include_other_subscription"].(bool)),
+		IncludeRecurring:         aws.Bool(m["include_recurring"].(bool)),
+		IncludeRefund:            aws.Bool(m["include_refund"].(bool)),
+		IncludeSubscription:      aws.Bool(m["include_subscription"].(bool)),
+		IncludeSupport:           aws.Bool(m["include_support"].(bool)),
+		IncludeTax:               aws.Bool(m["include_tax"].(bool)),
+		IncludeUpfront:           aws.Bool(m["include_upfront"].(bool)),
+		UseAmortized:             aws.Bool(m["use_amortized"].(bool)),
+		UseBlended:               aws.Bool(m["use_blended"].(bool)),
+	}
+}
+
+func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interface{} {
+	if costTypes == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"include_credit":             aws.BoolValue(costTypes.IncludeCredit),
+		"include_discount":           aws.BoolValue(costTypes.IncludeDiscount),
+		"include_other_subscription": aws.BoolValue(costTypes.IncludeOtherSubscription),
+		"include_recurring":          aws.BoolValue(costTypes.IncludeRecurring),
+		"include_refund":             aws.BoolValue(costTypes.IncludeRefund),
+		"include_subscription":       aws.BoolValue(costTypes.IncludeSubscription),
+		"include_support":            aws.BoolValue(costTypes.IncludeSupport),
+		"include_tax":                aws.BoolValue(costTypes.IncludeTax),
+		"include_upfront":            aws.BoolValue(costTypes.IncludeUpfront),
+		"use_amortized":              aws.BoolValue(costTypes.UseAmortized),
+		"use_blended":                aws.BoolValue(costTypes.UseBlended),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandBudgetsNotificationsWithSubscribers(l []interface{}) ([]*budgets.NotificationWithSubscribers, error) {
+	notifications := make([]*budgets.NotificationWithSubscribers, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		var subscribers []*budgets.Subscriber
+		for _, address := range m["subscriber_email_addresses"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+		for _, arn := range m["subscriber_sns_topic_arns"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(arn.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+
+		if len(subscribers) == 0 {
+			return nil, fmt.Errorf("Budget notifications must have at least one subscriber_email_addresses or subscriber_sns_topic_arns")
+		}
+
+		notifications = append(notifications, &budgets.NotificationWithSubscribers{
+			Notification: &budgets.Notification{
+				ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+				NotificationType:   aws.String(m["notification_type"].(string)),
+				Threshold:          aws.Float64(m["threshold"].(float64)),
+				ThresholdType:      aws.String(m["threshold_type"].(string)),
+			},
+			Subscribers: subscribers,
+		})
+	}
+
+	return notifications, nil
+}
+
+func flattenBudgetsNotification(notification *budgets.Notification, subscribers []*budgets.Subscriber) map[string]interface{} {
+	var emailAddresses, snsTopicArns []*string
+	for _, subscriber := range subscribers {
+		switch aws.StringValue(subscriber.SubscriptionType) {
+		case budgets.SubscriptionTypeEmail:
+			emailAddresses = append(emailAddresses, subscriber.Address)
+		case budgets.SubscriptionTypeSns:
+			snsTopicArns = append(snsTopicArns, subscriber.Address)
+		}
+	}
+
+	thresholdType := aws.StringValue(notification.ThresholdType)
+	if thresholdType == "" {
+		thresholdType = budgets.ThresholdTypePercentage
+	}
+
+	return map[string]interface{}{
+		"comparison_operator":        aws.StringValue(notification.ComparisonOperator),
+		"notification_type":          aws.StringValue(notification.NotificationType),
+		"subscriber_email_addresses": flattenStringList(emailAddresses),
+		"subscriber_sns_topic_arns":  flattenStringList(snsTopicArns),
+		"threshold":                  aws.Float64Value(notification.Threshold),
+		"threshold_type":             thresholdType,
+	}
+}
+
+func suppressEquivalentBudgetLimitAmount(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+func validateBudgetsTimePeriod(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(budgetsTimePeriodLayout, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be in the format YYYY-MM-DD_hh:mm: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSBudgetsBudget_basic(t *testing.T) {
+	var budget budgets.Budget
+	resourceName := "aws_budgets_budget.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig(rName, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "limit_unit", "USD"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "MONTHLY"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_start", "2017-01-01_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "cost_filters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cost_filters.Service", "Amazon Elastic Compute Cloud - Compute"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.include_tax", "false"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig(rName, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "200.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_notification(t *testing.T) {
+	var budget budgets.Budget
+	resourceName := "aws_budgets_budget.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfigNotification(rName, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfigNotification(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDecodeBudgetsBudgetID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		AccountID   string
+		BudgetName  string
+		ErrExpected bool
+	}{
+		{
+			ID:          "123456789012",
+			ErrExpected: true,
+		},
+		{
+			ID:          ":example",
+			ErrExpected: true,
+		},
+		{
+			ID:         "123456789012:example",
+			AccountID:  "123456789012",
+			BudgetName: "example",
+		},
+		{
+			ID:         "123456789012:example:with:colons",
+			AccountID:  "123456789012",
+			BudgetName: "example:with:colons",
+		},
+	}
+
+	for _, tc := range cases {
+		accountID, budgetName, err := decodeBudgetsBudgetID(tc.ID)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error decoding %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %s", tc.ID, err)
+		}
+		if accountID != tc.AccountID || budgetName != tc.BudgetName {
+			t.Fatalf("decoding %q: expected (%q, %q), got (%q, %q)", tc.ID, tc.AccountID, tc.BudgetName, accountID, budgetName)
+		}
+	}
+}
+
+func testAccCheckAWSBudgetsBudgetExists(n string, budget *budgets.Budget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Budget ID is set")
+		}
+
+		accountID, budgetName, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(budgetName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Budget == nil {
+			return fmt.Errorf("Budget %q not found", rs.Primary.ID)
+		}
+
+		*budget = *resp.Budget
+
+		return nil
+	}
+}
+
+func testAccCheckAWSBudgetsBudgetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget" {
+			continue
+		}
+
+		accountID, budgetName, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(budgetName),
+		})
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Budget %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetConfig(rName, limitAmount string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "%s"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters {
+    Service = "Amazon Elastic Compute Cloud - Compute"
+  }
+
+  cost_types {
+    include_tax = false
+  }
+}
+`, rName, limitAmount)
+}
+
+func testAccAWSBudgetsBudgetConfigNotification(rName string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = "%[1]s"
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    notification_type          = "ACTUAL"
+    threshold                  = %[2]d
+    threshold_type             = "PERCENTAGE"
+    subscriber_email_addresses = ["example@example.com"]
+  }
+
+  notification {
+    comparison_operator       = "GREATER_THAN"
+    notification_type         = "FORECASTED"
+    threshold                 = 100
+    threshold_type            = "PERCENTAGE"
+    subscriber_sns_topic_arns = ["${aws_sns_topic.test.arn}"]
+  }
+}
+`, rName, threshold)
+}
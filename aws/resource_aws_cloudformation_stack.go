@@ -102,10 +102,7 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags": TagsSchema(),
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -155,8 +152,8 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		input.Tags = expandCloudFormationTags(v)
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
 		m := int64(v.(int))
@@ -385,8 +382,23 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		// The tags given are the replacement set of tags for the stack, so
+		// carry the ignored ones over from it
+		var remoteTags map[string]string
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		if !ignoreTagsConfig.empty() {
+			resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+				StackName: aws.String(d.Id()),
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.Stacks) > 0 {
+				remoteTags = flattenCloudFormationTags(resp.Stacks[0].Tags)
+			}
+		}
+		input.Tags = expandCloudFormationTags(ignoreTagsConfig.MergeIgnored(v, remoteTags))
 	}
 
 	if d.HasChange("policy_body") {
@@ -563,7 +563,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               TagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               TagsFromMapCloudFront(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))),
 		},
 	}
 
@@ -639,7 +639,7 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if err := SetTagsCloudFront(conn, d, d.Get("arn").(string)); err != nil {
+	if err := SetTagsCloudFront(conn, d, d.Get("arn").(string), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
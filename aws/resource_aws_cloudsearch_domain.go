@@ -0,0 +1,797 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudSearchDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudSearchDomainCreate,
+		Read:   resourceAwsCloudSearchDomainRead,
+		Update: resourceAwsCloudSearchDomainUpdate,
+		Delete: resourceAwsCloudSearchDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"facet": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"highlight": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCloudSearchIndexFieldName,
+						},
+						"return": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"search": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sort": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudsearch.IndexFieldTypeDate,
+								cloudsearch.IndexFieldTypeDateArray,
+								cloudsearch.IndexFieldTypeDouble,
+								cloudsearch.IndexFieldTypeDoubleArray,
+								cloudsearch.IndexFieldTypeInt,
+								cloudsearch.IndexFieldTypeIntArray,
+								cloudsearch.IndexFieldTypeLatlon,
+								cloudsearch.IndexFieldTypeLiteral,
+								cloudsearch.IndexFieldTypeLiteralArray,
+								cloudsearch.IndexFieldTypeText,
+								cloudsearch.IndexFieldTypeTextArray,
+							}, false),
+						},
+					},
+				},
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudSearchDomainName,
+			},
+			"scaling_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudsearch.PartitionInstanceTypeSearchM1Small,
+								cloudsearch.PartitionInstanceTypeSearchM1Large,
+								cloudsearch.PartitionInstanceTypeSearchM2Xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM22xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM3Medium,
+								cloudsearch.PartitionInstanceTypeSearchM3Large,
+								cloudsearch.PartitionInstanceTypeSearchM3Xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM32xlarge,
+							}, false),
+						},
+						"desired_partition_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"desired_replication_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"search_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Creating CloudSearch Domain: %s", name)
+	_, err := conn.CreateDomain(&cloudsearch.CreateDomainInput{
+		DomainName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CloudSearch Domain (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := updateAwsCloudSearchDomain(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	domain, err := describeCloudSearchDomain(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading CloudSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	if domain == nil || aws.BoolValue(domain.Deleted) {
+		log.Printf("[WARN] CloudSearch Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", domain.ARN)
+	d.Set("domain_id", domain.DomainId)
+	d.Set("name", domain.DomainName)
+
+	if domain.DocService != nil {
+		d.Set("document_service_endpoint", domain.DocService.Endpoint)
+	}
+	if domain.SearchService != nil {
+		d.Set("search_service_endpoint", domain.SearchService.Endpoint)
+	}
+
+	scaling, err := conn.DescribeScalingParameters(&cloudsearch.DescribeScalingParametersInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CloudSearch Domain (%s) scaling parameters: %s", d.Id(), err)
+	}
+
+	if err := d.Set("scaling_parameters", flattenCloudSearchScalingParameters(scaling.ScalingParameters.Options)); err != nil {
+		return fmt.Errorf("Error setting scaling_parameters: %s", err)
+	}
+
+	availability, err := conn.DescribeAvailabilityOptions(&cloudsearch.DescribeAvailabilityOptionsInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CloudSearch Domain (%s) availability options: %s", d.Id(), err)
+	}
+
+	if availability.AvailabilityOptions != nil {
+		d.Set("multi_az", availability.AvailabilityOptions.Options)
+	}
+
+	policies, err := conn.DescribeServiceAccessPolicies(&cloudsearch.DescribeServiceAccessPoliciesInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CloudSearch Domain (%s) access policies: %s", d.Id(), err)
+	}
+
+	d.Set("access_policies", "")
+	if v := aws.StringValue(policies.AccessPolicies.Options); v != "" {
+		normalized, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return errwrap.Wrapf("access policies contain an invalid JSON: {{err}}", err)
+		}
+		d.Set("access_policies", normalized)
+	}
+
+	fields, err := conn.DescribeIndexFields(&cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CloudSearch Domain (%s) index fields: %s", d.Id(), err)
+	}
+
+	if err := d.Set("index_field", flattenCloudSearchIndexFields(fields.IndexFields)); err != nil {
+		return fmt.Errorf("Error setting index_field: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	if err := updateAwsCloudSearchDomain(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	log.Printf("[DEBUG] Deleting CloudSearch Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(&cloudsearch.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudsearch.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			domain, err := describeCloudSearchDomain(conn, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+
+			if domain == nil || (aws.BoolValue(domain.Deleted) && !aws.BoolValue(domain.Processing)) {
+				return d.Id(), "Deleted", nil
+			}
+
+			return domain, "Deleting", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for CloudSearch Domain (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateAwsCloudSearchDomain applies the domain configuration, reindexes the
+// documents when index fields changed and waits for processing to finish.
+func updateAwsCloudSearchDomain(conn *cloudsearch.CloudSearch, d *schema.ResourceData, timeout time.Duration) error {
+	isNew := d.IsNewResource()
+
+	if d.HasChange("scaling_parameters") {
+		if v, ok := d.GetOk("scaling_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudsearch.UpdateScalingParametersInput{
+				DomainName:        aws.String(d.Id()),
+				ScalingParameters: expandCloudSearchScalingParameters(v.([]interface{})[0].(map[string]interface{})),
+			}
+
+			log.Printf("[DEBUG] Updating CloudSearch Domain scaling parameters: %s", input)
+			if _, err := conn.UpdateScalingParameters(input); err != nil {
+				return fmt.Errorf("Error updating CloudSearch Domain (%s) scaling parameters: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("multi_az") && (!isNew || d.Get("multi_az").(bool)) {
+		input := &cloudsearch.UpdateAvailabilityOptionsInput{
+			DomainName: aws.String(d.Id()),
+			MultiAZ:    aws.Bool(d.Get("multi_az").(bool)),
+		}
+
+		log.Printf("[DEBUG] Updating CloudSearch Domain availability options: %s", input)
+		if _, err := conn.UpdateAvailabilityOptions(input); err != nil {
+			return fmt.Errorf("Error updating CloudSearch Domain (%s) availability options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("access_policies") {
+		input := &cloudsearch.UpdateServiceAccessPoliciesInput{
+			DomainName:     aws.String(d.Id()),
+			AccessPolicies: aws.String(d.Get("access_policies").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating CloudSearch Domain access policies: %s", input)
+		if _, err := conn.UpdateServiceAccessPolicies(input); err != nil {
+			return fmt.Errorf("Error updating CloudSearch Domain (%s) access policies: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("index_field") {
+		o, n := d.GetChange("index_field")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		newNames := make(map[string]bool)
+		for _, raw := range ns.List() {
+			newNames[raw.(map[string]interface{})["name"].(string)] = true
+		}
+
+		for _, raw := range os.Difference(ns).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if newNames[name] {
+				// Redefined below
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting CloudSearch Domain (%s) index field: %s", d.Id(), name)
+			_, err := conn.DeleteIndexField(&cloudsearch.DeleteIndexFieldInput{
+				DomainName:     aws.String(d.Id()),
+				IndexFieldName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("Error deleting CloudSearch Domain (%s) index field (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		for _, raw := range ns.Difference(os).List() {
+			field, err := expandCloudSearchIndexField(raw.(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+
+			input := &cloudsearch.DefineIndexFieldInput{
+				DomainName: aws.String(d.Id()),
+				IndexField: field,
+			}
+
+			log.Printf("[DEBUG] Defining CloudSearch Domain index field: %s", input)
+			if _, err := conn.DefineIndexField(input); err != nil {
+				return fmt.Errorf("Error defining CloudSearch Domain (%s) index field (%s): %s", d.Id(), aws.StringValue(field.IndexFieldName), err)
+			}
+		}
+
+		log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", d.Id())
+		_, err := conn.IndexDocuments(&cloudsearch.IndexDocumentsInput{
+			DomainName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error indexing CloudSearch Domain (%s) documents: %s", d.Id(), err)
+		}
+	}
+
+	if err := waitForCloudSearchDomainProcessing(conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("Error waiting for CloudSearch Domain (%s) to finish processing: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeCloudSearchDomain(conn *cloudsearch.CloudSearch, name string) (*cloudsearch.DomainStatus, error) {
+	resp, err := conn.DescribeDomains(&cloudsearch.DescribeDomainsInput{
+		DomainNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, domain := range resp.DomainStatusList {
+		if aws.StringValue(domain.DomainName) == name {
+			return domain, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func waitForCloudSearchDomainProcessing(conn *cloudsearch.CloudSearch, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Processing"},
+		Target:  []string{"Active"},
+		Refresh: func() (interface{}, string, error) {
+			domain, err := describeCloudSearchDomain(conn, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if domain == nil {
+				return nil, "", fmt.Errorf("CloudSearch Domain %q not found", name)
+			}
+
+			if !aws.BoolValue(domain.Created) || aws.BoolValue(domain.Processing) {
+				return domain, "Processing", nil
+			}
+
+			return domain, "Active", nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func validateCloudSearchDomainName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-z][a-z0-9-]{2,27}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must begin with a lowercase letter, contain only lowercase letters, numbers and hyphens, and be 3-28 characters long", k))
+	}
+	return
+}
+
+func validateCloudSearchIndexFieldName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^([a-z][a-z0-9_]{2,63}|\*[a-z0-9_]{1,63}|[a-z][a-z0-9_]{0,62}\*)$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must begin with a lowercase letter and contain only lowercase letters, numbers and underscores, or be a dynamic field pattern with a leading or trailing wildcard", k))
+	}
+	if value == "score" {
+		errors = append(errors, fmt.Errorf("%q cannot be %q", k, value))
+	}
+	return
+}
+
+func expandCloudSearchScalingParameters(m map[string]interface{}) *cloudsearch.ScalingParameters {
+	params := &cloudsearch.ScalingParameters{}
+
+	if v, ok := m["desired_instance_type"].(string); ok && v != "" {
+		params.DesiredInstanceType = aws.String(v)
+	}
+	if v, ok := m["desired_partition_count"].(int); ok && v > 0 {
+		params.DesiredPartitionCount = aws.Int64(int64(v))
+	}
+	if v, ok := m["desired_replication_count"].(int); ok && v > 0 {
+		params.DesiredReplicationCount = aws.Int64(int64(v))
+	}
+
+	return params
+}
+
+func flattenCloudSearchScalingParameters(params *cloudsearch.ScalingParameters) []map[string]interface{} {
+	if params == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"desired_instance_type":     aws.StringValue(params.DesiredInstanceType),
+			"desired_partition_count":   int(aws.Int64Value(params.DesiredPartitionCount)),
+			"desired_replication_count": int(aws.Int64Value(params.DesiredReplicationCount)),
+		},
+	}
+}
+
+func expandCloudSearchIndexField(m map[string]interface{}) (*cloudsearch.IndexField, error) {
+	name := m["name"].(string)
+	fieldType := m["type"].(string)
+
+	field := &cloudsearch.IndexField{
+		IndexFieldName: aws.String(name),
+		IndexFieldType: aws.String(fieldType),
+	}
+
+	analysisScheme := m["analysis_scheme"].(string)
+	defaultValue := m["default_value"].(string)
+	facet := aws.Bool(m["facet"].(bool))
+	highlight := aws.Bool(m["highlight"].(bool))
+	ret := aws.Bool(m["return"].(bool))
+	search := aws.Bool(m["search"].(bool))
+	sort := aws.Bool(m["sort"].(bool))
+	sourceFields := m["source_fields"].(string)
+
+	optionalString := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return aws.String(s)
+	}
+
+	switch fieldType {
+	case cloudsearch.IndexFieldTypeDate:
+		field.DateOptions = &cloudsearch.DateOptions{
+			DefaultValue:  optionalString(defaultValue),
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+			SourceField:   optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeDateArray:
+		field.DateArrayOptions = &cloudsearch.DateArrayOptions{
+			DefaultValue:  optionalString(defaultValue),
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SourceFields:  optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeDouble:
+		options := &cloudsearch.DoubleOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+			SourceField:   optionalString(sourceFields),
+		}
+		if defaultValue != "" {
+			f, err := strconv.ParseFloat(defaultValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid default_value %q for index field %q: %s", defaultValue, name, err)
+			}
+			options.DefaultValue = aws.Float64(f)
+		}
+		field.DoubleOptions = options
+	case cloudsearch.IndexFieldTypeDoubleArray:
+		options := &cloudsearch.DoubleArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SourceFields:  optionalString(sourceFields),
+		}
+		if defaultValue != "" {
+			f, err := strconv.ParseFloat(defaultValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid default_value %q for index field %q: %s", defaultValue, name, err)
+			}
+			options.DefaultValue = aws.Float64(f)
+		}
+		field.DoubleArrayOptions = options
+	case cloudsearch.IndexFieldTypeInt:
+		options := &cloudsearch.IntOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+			SourceField:   optionalString(sourceFields),
+		}
+		if defaultValue != "" {
+			i, err := strconv.ParseInt(defaultValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid default_value %q for index field %q: %s", defaultValue, name, err)
+			}
+			options.DefaultValue = aws.Int64(i)
+		}
+		field.IntOptions = options
+	case cloudsearch.IndexFieldTypeIntArray:
+		options := &cloudsearch.IntArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SourceFields:  optionalString(sourceFields),
+		}
+		if defaultValue != "" {
+			i, err := strconv.ParseInt(defaultValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid default_value %q for index field %q: %s", defaultValue, name, err)
+			}
+			options.DefaultValue = aws.Int64(i)
+		}
+		field.IntArrayOptions = options
+	case cloudsearch.IndexFieldTypeLatlon:
+		field.LatLonOptions = &cloudsearch.LatLonOptions{
+			DefaultValue:  optionalString(defaultValue),
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+			SourceField:   optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeLiteral:
+		field.LiteralOptions = &cloudsearch.LiteralOptions{
+			DefaultValue:  optionalString(defaultValue),
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+			SourceField:   optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeLiteralArray:
+		field.LiteralArrayOptions = &cloudsearch.LiteralArrayOptions{
+			DefaultValue:  optionalString(defaultValue),
+			FacetEnabled:  facet,
+			ReturnEnabled: ret,
+			SearchEnabled: search,
+			SourceFields:  optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeText:
+		field.TextOptions = &cloudsearch.TextOptions{
+			AnalysisScheme:   optionalString(analysisScheme),
+			DefaultValue:     optionalString(defaultValue),
+			HighlightEnabled: highlight,
+			ReturnEnabled:    ret,
+			SortEnabled:      sort,
+			SourceField:      optionalString(sourceFields),
+		}
+	case cloudsearch.IndexFieldTypeTextArray:
+		field.TextArrayOptions = &cloudsearch.TextArrayOptions{
+			AnalysisScheme:   optionalString(analysisScheme),
+			DefaultValue:     optionalString(defaultValue),
+			HighlightEnabled: highlight,
+			ReturnEnabled:    ret,
+			SourceFields:     optionalString(sourceFields),
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported index field type %q for index field %q", fieldType, name)
+	}
+
+	return field, nil
+}
+
+func flattenCloudSearchIndexFields(fields []*cloudsearch.IndexFieldStatus) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(fields))
+
+	for _, status := range fields {
+		if status == nil || status.Options == nil {
+			continue
+		}
+		if status.Status != nil && aws.BoolValue(status.Status.PendingDeletion) {
+			continue
+		}
+
+		field := status.Options
+		m := map[string]interface{}{
+			"analysis_scheme": "",
+			"default_value":   "",
+			"facet":           false,
+			"highlight":       false,
+			"name":            aws.StringValue(field.IndexFieldName),
+			"return":          false,
+			"search":          false,
+			"sort":            false,
+			"source_fields":   "",
+			"type":            aws.StringValue(field.IndexFieldType),
+		}
+
+		switch {
+		case field.DateOptions != nil:
+			o := field.DateOptions
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.DateArrayOptions != nil:
+			o := field.DateArrayOptions
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		case field.DoubleOptions != nil:
+			o := field.DoubleOptions
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatFloat(aws.Float64Value(o.DefaultValue), 'f', -1, 64)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.DoubleArrayOptions != nil:
+			o := field.DoubleArrayOptions
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatFloat(aws.Float64Value(o.DefaultValue), 'f', -1, 64)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		case field.IntOptions != nil:
+			o := field.IntOptions
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatInt(aws.Int64Value(o.DefaultValue), 10)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.IntArrayOptions != nil:
+			o := field.IntArrayOptions
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatInt(aws.Int64Value(o.DefaultValue), 10)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		case field.LatLonOptions != nil:
+			o := field.LatLonOptions
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.LiteralOptions != nil:
+			o := field.LiteralOptions
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.LiteralArrayOptions != nil:
+			o := field.LiteralArrayOptions
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		case field.TextOptions != nil:
+			o := field.TextOptions
+			m["analysis_scheme"] = aws.StringValue(o.AnalysisScheme)
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["highlight"] = aws.BoolValue(o.HighlightEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		case field.TextArrayOptions != nil:
+			o := field.TextArrayOptions
+			m["analysis_scheme"] = aws.StringValue(o.AnalysisScheme)
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["highlight"] = aws.BoolValue(o.HighlightEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateCloudSearchDomainName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"tf-acc-test-12345",
+		"a234567890123456789012345678",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudSearchDomainName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudSearch domain name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"1abc",
+		"Abc",
+		"abc_def",
+		"a2345678901234567890123456789",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudSearchDomainName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudSearch domain name", v)
+		}
+	}
+}
+
+func TestValidateCloudSearchIndexFieldName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"title_1",
+		"*_suffix",
+		"prefix_*",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudSearchIndexFieldName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudSearch index field name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"score",
+		"1abc",
+		"Title",
+		"with-hyphen",
+		"*",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudSearchIndexFieldName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudSearch index field name", v)
+		}
+	}
+}
+
+func TestExpandFlattenCloudSearchIndexField(t *testing.T) {
+	cases := []map[string]interface{}{
+		{
+			"analysis_scheme": "",
+			"default_value":   "10",
+			"facet":           true,
+			"highlight":       false,
+			"name":            "year",
+			"return":          true,
+			"search":          true,
+			"sort":            false,
+			"source_fields":   "",
+			"type":            "int",
+		},
+		{
+			"analysis_scheme": "_en_default_",
+			"default_value":   "",
+			"facet":           false,
+			"highlight":       true,
+			"name":            "title",
+			"return":          true,
+			"search":          false,
+			"sort":            true,
+			"source_fields":   "",
+			"type":            "text",
+		},
+		{
+			"analysis_scheme": "",
+			"default_value":   "1.5",
+			"facet":           false,
+			"highlight":       false,
+			"name":            "ratings",
+			"return":          true,
+			"search":          true,
+			"sort":            false,
+			"source_fields":   "rating",
+			"type":            "double-array",
+		},
+	}
+
+	for _, c := range cases {
+		field, err := expandCloudSearchIndexField(c)
+		if err != nil {
+			t.Fatalf("expanding %q: %s", c["name"], err)
+		}
+
+		flattened := flattenCloudSearchIndexFields([]*cloudsearch.IndexFieldStatus{
+			{
+				Options: field,
+				Status:  &cloudsearch.OptionStatus{State: aws.String(cloudsearch.OptionStateActive)},
+			},
+		})
+
+		if len(flattened) != 1 {
+			t.Fatalf("expected 1 index field, got %d", len(flattened))
+		}
+
+		if !reflect.DeepEqual(flattened[0], c) {
+			t.Fatalf("round trip mismatch:\nexpected: %#v\ngot: %#v", c, flattened[0])
+		}
+	}
+
+	if _, err := expandCloudSearchIndexField(map[string]interface{}{
+		"analysis_scheme": "",
+		"default_value":   "ten",
+		"facet":           false,
+		"highlight":       false,
+		"name":            "year",
+		"return":          false,
+		"search":          false,
+		"sort":            false,
+		"source_fields":   "",
+		"type":            "int",
+	}); err == nil {
+		t.Fatal("expected an error for a non-numeric int default_value")
+	}
+}
+
+func TestAccAWSCloudSearchDomain_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_instance_type", "search.m3.medium"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "document_service_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "search_service_endpoint"),
+				),
+			},
+			{
+				Config: testAccAWSCloudSearchDomainConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudSearchDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudSearch Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+		domain, err := describeCloudSearchDomain(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if domain == nil || aws.BoolValue(domain.Deleted) {
+			return fmt.Errorf("CloudSearch Domain %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudSearchDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudsearch_domain" {
+			continue
+		}
+
+		domain, err := describeCloudSearchDomain(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if domain != nil && !aws.BoolValue(domain.Deleted) {
+			return fmt.Errorf("CloudSearch Domain %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudSearchDomainConfig(rName string, extraField bool) string {
+	field := ""
+	if extraField {
+		field = `
+  index_field {
+    name   = "year"
+    type   = "int"
+    facet  = true
+    return = true
+    search = true
+    sort   = true
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  scaling_parameters {
+    desired_instance_type = "search.m3.medium"
+  }
+
+  index_field {
+    name            = "title"
+    type            = "text"
+    analysis_scheme = "_en_default_"
+    highlight       = true
+    return          = true
+    sort            = true
+  }
+
+  index_field {
+    name   = "genres"
+    type   = "literal-array"
+    facet  = true
+    return = true
+    search = true
+  }
+%[2]s
+  access_policies = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"},
+      "Action": "cloudsearch:search"
+    }
+  ]
+}
+POLICY
+}
+`, rName, field)
+}
@@ -351,7 +351,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if d.HasChange("tags") {
-		err := SetTagsCloudtrail(conn, d)
+		err := SetTagsCloudtrail(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig)
 		if err != nil {
 			return err
 		}
@@ -186,10 +186,10 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
+	if !restricted && (d.HasChange("tags") || d.IsNewResource()) {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := meta.(*AWSClient).ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := meta.(*AWSClient).ignoreTagsConfig.RemoveIgnored(meta.(*AWSClient).defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := diffCloudWatchTags(o, n)
 
 		if len(remove) > 0 {
@@ -268,8 +268,8 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.VpcConfig = expandCodeBuildVpcConfig(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.Tags = TagsFromMapCodeBuild(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = TagsFromMapCodeBuild(v)
 	}
 
 	var resp *codebuild.CreateProjectOutput
@@ -481,6 +481,12 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// codeBuildProjectUpdateTags returns the replacement set of tags for a
+// project, keeping the ignored tags currently on it.
+func codeBuildProjectUpdateTags(tags map[string]interface{}, remote []*codebuild.Tag, ignoreTagsConfig *IgnoreTagsConfig) []*codebuild.Tag {
+	return TagsFromMapCodeBuild(ignoreTagsConfig.MergeIgnored(tags, TagsToMapCodeBuild(remote)))
+}
+
 func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codebuildconn
 
@@ -525,7 +531,24 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 
 	// The documentation clearly says "The replacement set of tags for this build project."
 	// But its a slice of pointers so if not set for every update, they get removed.
-	params.Tags = TagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	// The same goes for the ignored tags, so carry them over from the project.
+	var remoteTags []*codebuild.Tag
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+	if !ignoreTagsConfig.empty() {
+		resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{
+				aws.String(d.Id()),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retreiving Projects: %q", err)
+		}
+		if len(resp.Projects) > 0 {
+			remoteTags = resp.Projects[0].Tags
+		}
+	}
+	tags := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))
+	params.Tags = codeBuildProjectUpdateTags(tags, remoteTags, ignoreTagsConfig)
 
 	_, err := conn.UpdateProject(params)
 
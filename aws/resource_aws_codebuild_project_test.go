@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -172,6 +173,39 @@ func TestAWSCodeBuildProject_nameValidation(t *testing.T) {
 	}
 }
 
+func TestAWSCodeBuildProject_updateTagsIgnored(t *testing.T) {
+	ignoreTagsConfig := &IgnoreTagsConfig{
+		Keys: []string{"CreatedBy"},
+	}
+	remote := []*codebuild.Tag{
+		{
+			Key:   aws.String("CreatedBy"),
+			Value: aws.String("cost-tooling"),
+		},
+		{
+			Key:   aws.String("Name"),
+			Value: aws.String("foo"),
+		},
+		{
+			Key:   aws.String("Environment"),
+			Value: aws.String("test"),
+		},
+	}
+	tags := map[string]interface{}{
+		"Name":      "bar",
+		"CreatedBy": "terraform",
+	}
+
+	m := TagsToMapCodeBuild(codeBuildProjectUpdateTags(tags, remote, ignoreTagsConfig))
+	expected := map[string]string{
+		"CreatedBy": "cost-tooling",
+		"Name":      "bar",
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad tags: %#v", m)
+	}
+}
+
 func testAccCheckAWSCodeBuildProjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -585,8 +585,8 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.UserPoolTags = TagsFromMapGeneric(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.UserPoolTags = TagsFromMapGeneric(v)
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
 
@@ -837,8 +837,21 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.UserPoolTags = TagsFromMapGeneric(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		// The tags given replace all the tags of the user pool, so carry the
+		// ignored ones over from it
+		var remoteTags map[string]string
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		if !ignoreTagsConfig.empty() {
+			resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+				UserPoolId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error reading Cognito User Pool (%s): %s", d.Id(), err)
+			}
+			remoteTags = TagsToMapGeneric(resp.UserPool.UserPoolTags)
+		}
+		params.UserPoolTags = TagsFromMapGeneric(ignoreTagsConfig.MergeIgnored(v, remoteTags))
 	}
 
 	log.Printf("[DEBUG] Updating Cognito User Pool: %s", params)
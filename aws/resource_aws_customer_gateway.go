@@ -100,7 +100,7 @@ func resourceAwsCustomerGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Create tags.
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -219,7 +219,7 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).ec2conn
 
 	// Update tags if required.
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
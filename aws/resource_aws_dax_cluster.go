@@ -154,7 +154,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := TagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapDax(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -318,7 +318,7 @@ func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for DAX Cluster, not updating Tags for cluster %s", d.Id())
 	} else {
-		if err := SetTagsDax(conn, d, arn); err != nil {
+		if err := SetTagsDax(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		}
 	}
@@ -67,7 +67,7 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
 	name := d.Get("name").(string)
-	tags := TagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapRDS(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	sourceIdsSet := d.Get("source_ids").(*schema.Set)
 	sourceIds := make([]*string, sourceIdsSet.Len())
@@ -266,7 +266,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if arn, err := buildRDSEventSubscriptionARN(d.Get("customer_aws_id").(string), d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).region); err == nil {
-		if err := SetTagsRDS(rdsconn, d, arn); err != nil {
+		if err := SetTagsRDS(rdsconn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsDbInstance() *schema.Resource {
@@ -290,6 +291,39 @@ func resourceAwsDbInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"snapshot_identifier",
+					"replicate_source_db",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_db_instance_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"restore_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validateRFC3339TimeString,
+							ConflictsWith: []string{"restore_to_point_in_time.0.use_latest_restorable_time"},
+						},
+						"use_latest_restorable_time": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.restore_time"},
+						},
+					},
+				},
+			},
+
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -340,6 +374,34 @@ func resourceAwsDbInstance() *schema.Resource {
 				Optional: true,
 			},
 
+			"performance_insights_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"performance_insights_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"enabled_cloudwatch_logs_exports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"audit",
+						"error",
+						"general",
+						"slowquery",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
 			"resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -357,7 +419,7 @@ func resourceAwsDbInstance() *schema.Resource {
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := TagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapRDS(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -426,6 +488,18 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
 
+		if attr := d.Get("enabled_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+			opts.EnableCloudwatchLogsExports = expandStringList(attr.List())
+		}
+
+		if attr, ok := d.GetOk("performance_insights_enabled"); ok {
+			opts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			opts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+		}
+
 		log.Printf("[DEBUG] DB Instance Replica create configuration: %#v", opts)
 		_, err := conn.CreateDBInstanceReadReplica(&opts)
 		if err != nil {
@@ -494,6 +568,10 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.StorageType = aws.String(attr.(string))
 		}
 
+		if attr := d.Get("enabled_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+			opts.EnableCloudwatchLogsExports = expandStringList(attr.List())
+		}
+
 		log.Printf("[DEBUG] DB Instance restore from snapshot configuration: %s", opts)
 		_, err := conn.RestoreDBInstanceFromDBSnapshot(&opts)
 		if err != nil {
@@ -502,6 +580,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 		var sgUpdate bool
 		var passwordUpdate bool
+		var performanceInsightsUpdate bool
 
 		if _, ok := d.GetOk("password"); ok {
 			passwordUpdate = true
@@ -513,8 +592,11 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		if attr := d.Get("security_group_names").(*schema.Set); attr.Len() > 0 {
 			sgUpdate = true
 		}
-		if sgUpdate || passwordUpdate {
-			log.Printf("[INFO] DB is restoring from snapshot with default security, but custom security should be set, will now update after snapshot is restored!")
+		if _, ok := d.GetOk("performance_insights_enabled"); ok {
+			performanceInsightsUpdate = true
+		}
+		if sgUpdate || passwordUpdate || performanceInsightsUpdate {
+			log.Printf("[INFO] DB is restoring from snapshot with default security and Performance Insights settings, but custom ones should be set, will now update after snapshot is restored!")
 
 			// wait for instance to get up and then modify security
 			d.SetId(d.Get("identifier").(string))
@@ -545,6 +627,160 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			}
 
 		}
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		pointInTime := v.([]interface{})[0].(map[string]interface{})
+
+		opts := rds.RestoreDBInstanceToPointInTimeInput{
+			SourceDBInstanceIdentifier: aws.String(pointInTime["source_db_instance_identifier"].(string)),
+			TargetDBInstanceIdentifier: aws.String(d.Get("identifier").(string)),
+			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
+			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+			PubliclyAccessible:         aws.Bool(d.Get("publicly_accessible").(bool)),
+			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			Tags:                       tags,
+		}
+
+		if v := pointInTime["restore_time"].(string); v != "" {
+			restoreTime, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return err
+			}
+			opts.RestoreTime = aws.Time(restoreTime)
+		} else if pointInTime["use_latest_restorable_time"].(bool) {
+			opts.UseLatestRestorableTime = aws.Bool(true)
+		} else {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: one of "restore_to_point_in_time.0.restore_time" or "restore_to_point_in_time.0.use_latest_restorable_time" must be set`, d.Get("identifier").(string))
+		}
+
+		if attr, ok := d.GetOk("name"); ok {
+			// Like snapshot restores, DBName is not accepted for these engines.
+			switch strings.ToLower(d.Get("engine").(string)) {
+			case "mysql", "postgres", "mariadb":
+				// skip
+			default:
+				opts.DBName = aws.String(attr.(string))
+			}
+		}
+
+		if attr, ok := d.GetOk("availability_zone"); ok {
+			opts.AvailabilityZone = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			opts.DBSubnetGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("engine"); ok {
+			opts.Engine = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iops"); ok {
+			opts.Iops = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("license_model"); ok {
+			opts.LicenseModel = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("multi_az"); ok {
+			opts.MultiAZ = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			opts.OptionGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("port"); ok {
+			opts.Port = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("tde_credential_arn"); ok {
+			opts.TdeCredentialArn = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("storage_type"); ok {
+			opts.StorageType = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+		}
+
+		if attr := d.Get("enabled_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+			opts.EnableCloudwatchLogsExports = expandStringList(attr.List())
+		}
+
+		log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", opts)
+		_, err := conn.RestoreDBInstanceToPointInTime(&opts)
+		if err != nil {
+			return fmt.Errorf("Error creating DB Instance: %s", err)
+		}
+
+		// Security groups, the master password, the parameter group, the
+		// backup retention period and window, the maintenance window,
+		// enhanced monitoring and Performance Insights cannot be set by the
+		// restore itself, so apply them once the instance is available.
+		var modifyUpdate bool
+
+		if _, ok := d.GetOk("password"); ok {
+			modifyUpdate = true
+		}
+		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+			modifyUpdate = true
+		}
+		if attr := d.Get("security_group_names").(*schema.Set); attr.Len() > 0 {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("parameter_group_name"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("backup_retention_period"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("backup_window"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("maintenance_window"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("monitoring_interval"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("monitoring_role_arn"); ok {
+			modifyUpdate = true
+		}
+		if _, ok := d.GetOk("performance_insights_enabled"); ok {
+			modifyUpdate = true
+		}
+
+		if modifyUpdate {
+			d.SetId(d.Get("identifier").(string))
+
+			log.Printf("[INFO] DB Instance ID: %s", d.Id())
+
+			log.Println(
+				"[INFO] Waiting for DB Instance to be available")
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    resourceAwsDbInstanceCreatePendingStates,
+				Target:     []string{"available", "storage-optimization"},
+				Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+				MinTimeout: 10 * time.Second,
+				Delay:      30 * time.Second, // Wait 30 secs before starting
+			}
+
+			// Wait, catching any errors
+			_, err := stateConf.WaitForState()
+			if err != nil {
+				return err
+			}
+
+			err = resourceAwsDbInstanceUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		if _, ok := d.GetOk("allocated_storage"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
@@ -659,6 +895,18 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
 		}
 
+		if attr := d.Get("enabled_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+			opts.EnableCloudwatchLogsExports = expandStringList(attr.List())
+		}
+
+		if attr, ok := d.GetOk("performance_insights_enabled"); ok {
+			opts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			opts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+		}
+
 		log.Printf("[DEBUG] DB Instance create configuration: %#v", opts)
 		var err error
 		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -736,6 +984,11 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("kms_key_id", v.KmsKeyId)
 	d.Set("port", v.DbInstancePort)
 	d.Set("iam_database_authentication_enabled", v.IAMDatabaseAuthenticationEnabled)
+	d.Set("performance_insights_enabled", v.PerformanceInsightsEnabled)
+	d.Set("performance_insights_kms_key_id", v.PerformanceInsightsKMSKeyId)
+	if err := d.Set("enabled_cloudwatch_logs_exports", flattenStringList(v.EnabledCloudwatchLogsExports)); err != nil {
+		return fmt.Errorf("Error setting enabled_cloudwatch_logs_exports: %s", err)
+	}
 	if v.DBSubnetGroup != nil {
 		d.Set("db_subnet_group_name", v.DBSubnetGroup.DBSubnetGroupName)
 	}
@@ -1025,6 +1278,30 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		requestUpdate = true
 	}
 
+	if d.HasChange("performance_insights_enabled") {
+		d.SetPartial("performance_insights_enabled")
+		req.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("performance_insights_kms_key_id") {
+		d.SetPartial("performance_insights_kms_key_id")
+		req.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("enabled_cloudwatch_logs_exports") {
+		d.SetPartial("enabled_cloudwatch_logs_exports")
+		o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		req.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  expandStringList(ns.Difference(os).List()),
+			DisableLogTypes: expandStringList(os.Difference(ns).List()),
+		}
+		requestUpdate = true
+	}
+
 	log.Printf("[DEBUG] Send DB Instance Modification request: %t", requestUpdate)
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
@@ -1074,7 +1351,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := SetTagsRDS(conn, d, arn); err != nil {
+		if err := SetTagsRDS(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
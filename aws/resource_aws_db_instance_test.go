@@ -254,6 +254,92 @@ func TestAccAWSDBInstance_iamAuth(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_cloudwatchLogsExportConfiguration(t *testing.T) {
+	var v rds.DBInstance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigCloudwatchLogsExportConfiguration(rInt, `"audit", "error"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "enabled_cloudwatch_logs_exports.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSDBInstanceConfigCloudwatchLogsExportConfiguration(rInt, `"error", "general", "slowquery"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "enabled_cloudwatch_logs_exports.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstance_restoreToPointInTime(t *testing.T) {
+	var source, restored rds.DBInstance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigRestoreToPointInTime(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &source),
+					testAccCheckAWSDBInstanceExists("aws_db_instance.restore", &restored),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restore", "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"aws_db_instance.restore", "engine", "aws_db_instance.bar", "engine"),
+					resource.TestCheckResourceAttrPair(
+						"aws_db_instance.restore", "allocated_storage", "aws_db_instance.bar", "allocated_storage"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstance_performanceInsights(t *testing.T) {
+	var v rds.DBInstance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigPerformanceInsights(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "performance_insights_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"aws_db_instance.bar", "performance_insights_kms_key_id", "aws_kms_key.foo", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSDBInstanceConfigPerformanceInsights(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "performance_insights_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_replica(t *testing.T) {
 	var s, r rds.DBInstance
 
@@ -900,6 +986,76 @@ resource "aws_db_instance" "bar" {
 }`, n)
 }
 
+func testAccAWSDBInstanceConfigCloudwatchLogsExportConfiguration(n int, logTypes string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+	identifier = "foobarbaz-test-terraform-%d"
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.7"
+	instance_class = "db.t2.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+	backup_retention_period = 0
+	skip_final_snapshot = true
+	apply_immediately = true
+	enabled_cloudwatch_logs_exports = [%s]
+}`, n, logTypes)
+}
+
+func testAccAWSDBInstanceConfigRestoreToPointInTime(n int) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+	identifier = "foobarbaz-test-terraform-%[1]d"
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.6.35"
+	instance_class = "db.t2.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+	backup_retention_period = 1
+	skip_final_snapshot = true
+	parameter_group_name = "default.mysql5.6"
+}
+
+resource "aws_db_instance" "restore" {
+	identifier = "foobarbaz-test-terraform-restore-%[1]d"
+	instance_class = "db.t2.micro"
+	skip_final_snapshot = true
+
+	restore_to_point_in_time {
+		source_db_instance_identifier = "${aws_db_instance.bar.identifier}"
+		use_latest_restorable_time = true
+	}
+}`, n)
+}
+
+func testAccAWSDBInstanceConfigPerformanceInsights(n int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "foo" {
+	description = "Terraform acc test %[1]d"
+	deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "bar" {
+	identifier = "foobarbaz-test-terraform-%[1]d"
+	allocated_storage = 10
+	engine = "postgres"
+	engine_version = "10.1"
+	instance_class = "db.m4.large"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+	backup_retention_period = 0
+	skip_final_snapshot = true
+	apply_immediately = true
+	performance_insights_enabled = %[2]t
+	performance_insights_kms_key_id = "${aws_kms_key.foo.arn}"
+}`, n, enabled)
+}
+
 func testAccReplicaInstanceConfig(val int) string {
 	return fmt.Sprintf(`
 	resource "aws_db_instance" "bar" {
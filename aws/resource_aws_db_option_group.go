@@ -119,7 +119,7 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := TagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapRDS(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -285,7 +285,7 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if arn, err := buildRDSOptionGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := SetTagsRDS(rdsconn, d, arn); err != nil {
+		if err := SetTagsRDS(rdsconn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -230,7 +230,7 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
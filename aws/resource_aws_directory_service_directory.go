@@ -407,7 +407,7 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if err := SetTagsDS(dsconn, d, d.Id()); err != nil {
+	if err := SetTagsDS(dsconn, d, d.Id(), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
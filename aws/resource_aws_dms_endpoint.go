@@ -109,10 +109,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 					dms.DmsSslModeValueVerifyFull,
 				}, false),
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags": TagsSchema(),
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -128,7 +125,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               dmsTagsFromMap(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))),
 	}
 
 	// if dynamodb then add required params
@@ -129,7 +129,7 @@ func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) err
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("dxcon/%s", d.Id()),
 	}.String()
-	if err := SetTagsDX(conn, d, arn); err != nil {
+	if err := SetTagsDX(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
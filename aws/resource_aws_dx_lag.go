@@ -170,7 +170,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("dxlag/%s", d.Id()),
 	}.String()
-	if err := SetTagsDX(conn, d, arn); err != nil {
+	if err := SetTagsDX(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
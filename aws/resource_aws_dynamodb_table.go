@@ -125,6 +125,20 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 					},
 				},
 			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -442,8 +456,14 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbPITR(d, conn); err != nil {
+			return fmt.Errorf("Error updating DynamoDB Table (%s) point in time recovery: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
-		if err := SetTagsDynamoDb(conn, d); err != nil {
+		if err := SetTagsDynamoDb(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		}
 	}
@@ -485,6 +505,16 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	pitrOut, err := conn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading DynamoDB Table (%s) continuous backups: %s", d.Id(), err)
+	}
+	if err := d.Set("point_in_time_recovery", flattenDynamoDbPitr(pitrOut.ContinuousBackupsDescription)); err != nil {
+		return fmt.Errorf("Error setting point_in_time_recovery: %s", err)
+	}
+
 	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn)
 	if err != nil {
 		return err
@@ -608,6 +638,35 @@ func updateDynamoDbTimeToLive(d *schema.ResourceData, conn *dynamodb.DynamoDB) e
 	return nil
 }
 
+func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
+	toEnable := false
+	if v, ok := d.GetOk("point_in_time_recovery"); ok {
+		if l := v.([]interface{}); len(l) > 0 && l[0] != nil {
+			toEnable = l[0].(map[string]interface{})["enabled"].(bool)
+		}
+	}
+
+	input := &dynamodb.UpdateContinuousBackupsInput{
+		TableName: aws.String(d.Id()),
+		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: aws.Bool(toEnable),
+		},
+	}
+
+	log.Printf("[DEBUG] Updating DynamoDB point in time recovery: %s", input)
+	// Continuous backups are briefly unavailable after a table is created
+	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.UpdateContinuousBackups(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeContinuousBackupsUnavailableException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 func readDynamoDbTableTags(arn string, conn *dynamodb.DynamoDB) (map[string]string, error) {
 	output, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{
 		ResourceArn: aws.String(arn),
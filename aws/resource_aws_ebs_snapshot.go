@@ -55,9 +55,10 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 			},
 
 			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDefaultTagsDiff(nil),
 			},
 		},
 	}
@@ -85,7 +86,7 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		log.Printf("[WARN] error setting tags: %s", err)
 	}
 
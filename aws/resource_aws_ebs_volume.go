@@ -139,10 +139,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
-		if err := SetTags(conn, d); err != nil {
-			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
-		}
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 	}
 
 	return resourceAwsEbsVolumeRead(d, meta)
@@ -151,7 +149,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	if _, ok := d.GetOk("tags"); ok {
-		if err := SetTags(conn, d); err != nil {
+		if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 var taskDefinitionRE = regexp.MustCompile("^([a-zA-Z0-9_-]+):([0-9]+)$")
@@ -204,6 +205,29 @@ func resourceAwsEcsService() *schema.Resource {
 					},
 				},
 			},
+
+			"service_registries": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"registry_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -265,6 +289,10 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
 
+	if v, ok := d.GetOk("service_registries"); ok {
+		input.ServiceRegistries = expandEcsServiceRegistries(v.(*schema.Set).List())
+	}
+
 	strategies := d.Get("placement_strategy").(*schema.Set).List()
 	if len(strategies) > 0 {
 		var ps []*ecs.PlacementStrategy
@@ -445,6 +473,10 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[ERR] Error setting network_configuration for (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("service_registries", flattenEcsServiceRegistries(service.ServiceRegistries)); err != nil {
+		return fmt.Errorf("[ERR] Error setting service_registries for (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -484,6 +516,40 @@ func expandEcsNetworkConfiguration(nc []interface{}) *ecs.NetworkConfiguration {
 	return &ecs.NetworkConfiguration{AwsvpcConfiguration: awsVpcConfig}
 }
 
+func expandEcsServiceRegistries(l []interface{}) []*ecs.ServiceRegistry {
+	result := make([]*ecs.ServiceRegistry, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		sr := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(m["registry_arn"].(string)),
+		}
+		if raw, ok := m["port"].(int); ok && raw != 0 {
+			sr.Port = aws.Int64(int64(raw))
+		}
+
+		result = append(result, sr)
+	}
+	return result
+}
+
+func flattenEcsServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
+	if len(srs) == 0 {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0)
+	for _, sr := range srs {
+		c := map[string]interface{}{
+			"registry_arn": aws.StringValue(sr.RegistryArn),
+		}
+		if sr.Port != nil {
+			c["port"] = int(aws.Int64Value(sr.Port))
+		}
+
+		results = append(results, c)
+	}
+	return results
+}
+
 func flattenServicePlacementConstraints(pcs []*ecs.PlacementConstraint) []map[string]interface{} {
 	if len(pcs) == 0 {
 		return nil
@@ -161,7 +161,7 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).efsconn
-	err := SetTagsEFS(conn, d)
+	err := SetTagsEFS(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
 			d.Id(), err.Error())
@@ -120,10 +120,8 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] EIP ID: %s (domain: %v)", d.Id(), *allocResp.Domain)
 
-	if _, ok := d.GetOk("tags"); ok {
-		if err := SetTags(ec2conn, d); err != nil {
-			return fmt.Errorf("Error creating EIP tags: %s", err)
-		}
+	if err := SetTags(ec2conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error creating EIP tags: %s", err)
 	}
 
 	return resourceAwsEipUpdate(d, meta)
@@ -302,7 +300,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, ok := d.GetOk("tags"); ok {
-		if err := SetTags(ec2conn, d); err != nil {
+		if err := SetTags(ec2conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return fmt.Errorf("Error updating EIP tags: %s", err)
 		}
 	}
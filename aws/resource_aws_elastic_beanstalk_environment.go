@@ -219,7 +219,7 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 		EnvironmentName: aws.String(name),
 		ApplicationName: aws.String(app),
 		OptionSettings:  extractOptionSettings(settings),
-		Tags:            TagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            TagsFromMapBeanstalk(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if desc != "" {
@@ -458,8 +458,9 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
-		oldTags := TagsFromMapBeanstalk(o.(map[string]interface{}))
-		newTags := TagsFromMapBeanstalk(n.(map[string]interface{}))
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		oldTags := TagsFromMapBeanstalk(ignoreTagsConfig.RemoveIgnored(o.(map[string]interface{})))
+		newTags := TagsFromMapBeanstalk(ignoreTagsConfig.RemoveIgnored(meta.(*AWSClient).defaultTagsConfig.MergeTags(n.(map[string]interface{}))))
 
 		tagsToAdd, tagNamesToRemove := DiffTagsBeanstalk(oldTags, newTags)
 
@@ -418,9 +418,9 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := TagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapElasticsearchService(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
-	if err := SetTagsElasticsearchService(conn, d, *out.DomainStatus.ARN); err != nil {
+	if err := SetTagsElasticsearchService(conn, d, *out.DomainStatus.ARN, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -571,7 +571,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(true)
 
-	if err := SetTagsElasticsearchService(conn, d, d.Id()); err != nil {
+	if err := SetTagsElasticsearchService(conn, d, d.Id(), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -506,9 +506,8 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		steps := v.([]interface{})
 		params.Steps = expandEmrStepConfigs(steps)
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		tagsIn := v.(map[string]interface{})
-		params.Tags = expandTags(tagsIn)
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = expandTags(v)
 	}
 	if v, ok := d.GetOk("configurations"); ok {
 		confUrl := v.(string)
@@ -762,7 +761,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if err := SetTagsEMR(conn, d); err != nil {
+	if err := SetTagsEMR(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
@@ -1088,11 +1087,11 @@ func DiffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
 	return expandTags(create), remove
 }
 
-func SetTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func SetTagsEMR(conn *emr.EMR, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsEMR(expandTags(o), expandTags(n))
 
 		// Set tags
@@ -110,7 +110,7 @@ func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) err
 func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 	glacierconn := meta.(*AWSClient).glacierconn
 
-	if err := setGlacierVaultTags(glacierconn, d); err != nil {
+	if err := setGlacierVaultTags(glacierconn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -272,11 +272,11 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 	return nil
 }
 
-func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
 
 		// Set tags
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueClassifier_GrokClassifier(t *testing.T) {
+	var classifier glue.Classifier
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_classifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueClassifierConfig_GrokClassifier(rName, "classification1", "pattern1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.classification", "classification1"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.grok_pattern", "pattern1"),
+					resource.TestCheckResourceAttr(resourceName, "json_classifier.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSGlueClassifierConfig_GrokClassifier(rName, "classification2", "pattern2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.classification", "classification2"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.grok_pattern", "pattern2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueClassifier_TypeChange(t *testing.T) {
+	var classifier glue.Classifier
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_classifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueClassifierConfig_GrokClassifier(rName, "classification1", "pattern1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "json_classifier.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSGlueClassifierConfig_JsonClassifier(rName, "$.prefix.key"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "json_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "json_classifier.0.json_path", "$.prefix.key"),
+				),
+			},
+			{
+				Config: testAccAWSGlueClassifierConfig_XmlClassifier(rName, "classification1", "rowtag1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "json_classifier.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.0.classification", "classification1"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.0.row_tag", "rowtag1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueClassifierExists(resourceName string, classifier *glue.Classifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Classifier ID is set")
+		}
+
+		glueconn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := glueconn.GetClassifier(&glue.GetClassifierInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Classifier == nil {
+			return fmt.Errorf("Glue Classifier (%s) not found", rs.Primary.ID)
+		}
+
+		*classifier = *output.Classifier
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueClassifierDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_classifier" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetClassifier(&glue.GetClassifierInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		if output.Classifier != nil {
+			return fmt.Errorf("Glue Classifier %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueClassifierConfig_GrokClassifier(rName, classification, grokPattern string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_classifier" "test" {
+  name = "%s"
+
+  grok_classifier {
+    classification = "%s"
+    grok_pattern   = "%s"
+  }
+}
+`, rName, classification, grokPattern)
+}
+
+func testAccAWSGlueClassifierConfig_JsonClassifier(rName, jsonPath string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_classifier" "test" {
+  name = "%s"
+
+  json_classifier {
+    json_path = "%s"
+  }
+}
+`, rName, jsonPath)
+}
+
+func testAccAWSGlueClassifierConfig_XmlClassifier(rName, classification, rowTag string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_classifier" "test" {
+  name = "%s"
+
+  xml_classifier {
+    classification = "%s"
+    row_tag        = "%s"
+  }
+}
+`, rName, classification, rowTag)
+}
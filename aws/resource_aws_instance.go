@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -41,7 +43,8 @@ func resourceAwsInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -68,7 +71,59 @@ func resourceAwsInstance() *schema.Resource {
 
 			"instance_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "standard",
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"launch_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "$Default",
+							ValidateFunc: validateLaunchTemplateVersion,
+						},
+					},
+				},
 			},
 
 			"key_name": {
@@ -225,6 +280,7 @@ func resourceAwsInstance() *schema.Resource {
 			"ebs_optimized": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -455,7 +511,9 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Build the creation struct
 	runOpts := &ec2.RunInstancesInput{
+		LaunchTemplate:        instanceOpts.LaunchTemplate,
 		BlockDeviceMappings:   instanceOpts.BlockDeviceMappings,
+		CreditSpecification:   instanceOpts.CreditSpecification,
 		DisableApiTermination: instanceOpts.DisableAPITermination,
 		EbsOptimized:          instanceOpts.EBSOptimized,
 		Monitoring:            instanceOpts.Monitoring,
@@ -477,6 +535,14 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		UserData:                          instanceOpts.UserData64,
 	}
 
+	if runOpts.ImageId == nil && runOpts.LaunchTemplate == nil {
+		return fmt.Errorf("One of `ami` or `launch_template` must be specified")
+	}
+
+	if runOpts.InstanceType == nil && runOpts.LaunchTemplate == nil {
+		return fmt.Errorf("One of `instance_type` or `launch_template` must be specified")
+	}
+
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
@@ -488,8 +554,8 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := TagsFromMap(v.(map[string]interface{}))
+		if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+			tags := TagsFromMap(v)
 
 			spec := &ec2.TagSpecification{
 				ResourceType: aws.String("instance"),
@@ -643,6 +709,14 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
+
+	if err := readInstanceLaunchTemplate(d, instance, conn); err != nil {
+		return err
+	}
+
+	if err := readInstanceCreditSpecification(d, instance, conn); err != nil {
+		return err
+	}
 	d.Set("key_name", instance.KeyName)
 	d.Set("public_dns", instance.PublicDnsName)
 	d.Set("public_ip", instance.PublicIpAddress)
@@ -770,7 +844,13 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		if attr.UserData != nil && attr.UserData.Value != nil {
+		_, ud := d.GetOk("user_data")
+		_, lt := d.GetOk("launch_template")
+		if attr.UserData != nil && attr.UserData.Value != nil && (ud || !lt) {
+			// User data inherited from a launch template is left out of
+			// state unless it is also configured, as user_data forces a
+			// new resource.
+			//
 			// Since user_data and user_data_base64 conflict with each other,
 			// we'll only set one or the other here to avoid a perma-diff.
 			// Since user_data_base64 was added later, we'll prefer to set
@@ -807,7 +887,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("tags") {
 		if !d.IsNewResource() || restricted {
-			if err := SetTags(conn, d); err != nil {
+			if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 				return err
 			} else {
 				d.SetPartial("tags")
@@ -816,7 +896,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("volume_tags") {
 		if !d.IsNewResource() || !restricted {
-			if err := SetVolumeTags(conn, d); err != nil {
+			if err := SetVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 				return err
 			} else {
 				d.SetPartial("volume_tags")
@@ -1061,6 +1141,34 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("credit_specification") && !d.IsNewResource() {
+		// Removing the block reverts the instance to the default
+		cpuCredits := "standard"
+		if v := d.Get("credit_specification").([]interface{}); len(v) > 0 && v[0] != nil {
+			cpuCredits = v[0].(map[string]interface{})["cpu_credits"].(string)
+		}
+
+		log.Printf("[DEBUG] Modifying credit specification for Instance (%s) to %s", d.Id(), cpuCredits)
+		resp, err := conn.ModifyInstanceCreditSpecification(&ec2.ModifyInstanceCreditSpecificationInput{
+			InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+				{
+					InstanceId: aws.String(d.Id()),
+					CpuCredits: aws.String(cpuCredits),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error modifying credit specification for Instance (%s): %s", d.Id(), err)
+		}
+		for _, item := range resp.UnsuccessfulInstanceCreditSpecifications {
+			if item.Error != nil {
+				return fmt.Errorf("Error modifying credit specification for Instance (%s): %s: %s",
+					d.Id(), aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+			}
+		}
+		d.SetPartial("credit_specification")
+	}
+
 	if d.HasChange("monitoring") {
 		var mErr error
 		if d.Get("monitoring").(bool) {
@@ -1613,6 +1721,7 @@ func getAwsEc2InstancePasswordData(instanceID string, conn *ec2.EC2) (string, er
 
 type awsInstanceOpts struct {
 	BlockDeviceMappings               []*ec2.BlockDeviceMapping
+	CreditSpecification               *ec2.CreditSpecificationRequest
 	DisableAPITermination             *bool
 	EBSOptimized                      *bool
 	Monitoring                        *ec2.RunInstancesMonitoringEnabled
@@ -1623,6 +1732,7 @@ type awsInstanceOpts struct {
 	Ipv6AddressCount                  *int64
 	Ipv6Addresses                     []*ec2.InstanceIpv6Address
 	KeyName                           *string
+	LaunchTemplate                    *ec2.LaunchTemplateSpecification
 	NetworkInterfaces                 []*ec2.InstanceNetworkInterfaceSpecification
 	Placement                         *ec2.Placement
 	PrivateIPAddress                  *string
@@ -1640,20 +1750,47 @@ func buildAwsInstanceOpts(
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
 		EBSOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
-		ImageID:               aws.String(d.Get("ami").(string)),
-		InstanceType:          aws.String(d.Get("instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("launch_template"); ok {
+		opts.LaunchTemplate = expandEc2LaunchTemplateSpecification(v.([]interface{}))
+
+		// Only override the template where the instance configuration
+		// says so explicitly.
+		if _, ok := d.GetOkExists("ebs_optimized"); !ok {
+			opts.EBSOptimized = nil
+		}
+	}
+
+	if v := d.Get("ami").(string); v != "" {
+		opts.ImageID = aws.String(v)
+	}
+
+	if v := d.Get("instance_type").(string); v != "" {
+		opts.InstanceType = aws.String(v)
 	}
 
 	if v := d.Get("instance_initiated_shutdown_behavior").(string); v != "" {
 		opts.InstanceInitiatedShutdownBehavior = aws.String(v)
 	}
 
+	if v, ok := d.GetOk("credit_specification"); ok {
+		if l := v.([]interface{}); len(l) > 0 && l[0] != nil {
+			m := l[0].(map[string]interface{})
+			opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+				CpuCredits: aws.String(m["cpu_credits"].(string)),
+			}
+		}
+	}
+
 	opts.Monitoring = &ec2.RunInstancesMonitoringEnabled{
 		Enabled: aws.Bool(d.Get("monitoring").(bool)),
 	}
 
-	opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
-		Name: aws.String(d.Get("iam_instance_profile").(string)),
+	if v := d.Get("iam_instance_profile").(string); v != "" || opts.LaunchTemplate == nil {
+		opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
+			Name: aws.String(v),
+		}
 	}
 
 	userData := d.Get("user_data").(string)
@@ -1789,6 +1926,117 @@ func awsTerminateInstance(conn *ec2.EC2, id string, d *schema.ResourceData) erro
 	return nil
 }
 
+func expandEc2LaunchTemplateSpecification(l []interface{}) *ec2.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &ec2.LaunchTemplateSpecification{}
+
+	if v, ok := m["id"].(string); ok && v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	}
+	if v, ok := m["name"].(string); ok && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+	if v, ok := m["version"].(string); ok && v != "" {
+		spec.Version = aws.String(v)
+	}
+
+	return spec
+}
+
+// readInstanceLaunchTemplate sets launch_template from the tags EC2 adds to
+// instances launched from a template. Those tags only record the resolved
+// version number, so a configured $Latest or $Default is kept as is.
+func readInstanceLaunchTemplate(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
+	var id, version string
+	for _, t := range instance.Tags {
+		switch aws.StringValue(t.Key) {
+		case "aws:ec2launchtemplate:id":
+			id = aws.StringValue(t.Value)
+		case "aws:ec2launchtemplate:version":
+			version = aws.StringValue(t.Value)
+		}
+	}
+
+	if id == "" {
+		return d.Set("launch_template", []interface{}{})
+	}
+
+	resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(id)},
+	})
+	if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+		// The template is gone, which doesn't affect the running instance
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading launch template (%s) for instance %s: %s", id, d.Id(), err)
+	}
+	if len(resp.LaunchTemplates) == 0 {
+		return nil
+	}
+
+	lt := resp.LaunchTemplates[0]
+	switch configured := d.Get("launch_template.0.version").(string); {
+	case configured == "$Latest" || configured == "$Default":
+		// Keep the symbolic version even once it has moved on, as
+		// publishing a new template version shouldn't replace instances
+		// that are already running.
+		version = configured
+	case configured == "" && version == strconv.FormatInt(aws.Int64Value(lt.DefaultVersionNumber), 10):
+		// Imported instances launched from the default version
+		version = "$Default"
+	}
+
+	return d.Set("launch_template", []interface{}{
+		map[string]interface{}{
+			"id":      aws.StringValue(lt.LaunchTemplateId),
+			"name":    aws.StringValue(lt.LaunchTemplateName),
+			"version": version,
+		},
+	})
+}
+
+// readInstanceCreditSpecification sets credit_specification for burstable
+// instances. The default "standard" setting is only stored when configured,
+// so that instances which never mention it don't show a diff.
+func readInstanceCreditSpecification(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
+	// Credit specifications are only supported for T2 instances; other
+	// instance types return an InstanceCreditSpecification.NotSupported error.
+	if !strings.HasPrefix(aws.StringValue(instance.InstanceType), "t2.") {
+		return d.Set("credit_specification", []interface{}{})
+	}
+
+	resp, err := conn.DescribeInstanceCreditSpecifications(&ec2.DescribeInstanceCreditSpecificationsInput{
+		InstanceIds: []*string{instance.InstanceId},
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing credit specification for Instance (%s): %s", d.Id(), err)
+	}
+
+	var cpuCredits string
+	for _, cs := range resp.InstanceCreditSpecifications {
+		if aws.StringValue(cs.InstanceId) == aws.StringValue(instance.InstanceId) {
+			cpuCredits = aws.StringValue(cs.CpuCredits)
+		}
+	}
+
+	_, configured := d.GetOk("credit_specification")
+	_, lt := d.GetOk("launch_template")
+	if !configured && (lt || cpuCredits == "" || cpuCredits == "standard") {
+		return d.Set("credit_specification", []interface{}{})
+	}
+
+	return d.Set("credit_specification", []interface{}{
+		map[string]interface{}{
+			"cpu_credits": cpuCredits,
+		},
+	})
+}
+
 func iamInstanceProfileArnToName(ip *ec2.IamInstanceProfile) string {
 	if ip == nil || ip.Arn == nil {
 		return ""
@@ -1335,6 +1335,84 @@ func TestAccAWSInstance_associatePublic_overridePrivate(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_launchTemplate(t *testing.T) {
+	var before, after ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplate(rInt, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &before),
+					resource.TestCheckResourceAttrPair(resName, "launch_template.0.id", "aws_launch_template.foo", "id"),
+					resource.TestCheckResourceAttrPair(resName, "launch_template.0.name", "aws_launch_template.foo", "name"),
+					resource.TestCheckResourceAttr(resName, "launch_template.0.version", "$Latest"),
+					resource.TestCheckResourceAttrPair(resName, "ami", "aws_launch_template.foo", "image_id"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+				),
+			},
+			{
+				// A new template version must not replace the instance
+				Config: testAccInstanceConfigLaunchTemplate(rInt, "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resName, "launch_template.0.version", "$Latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_creditSpecification(t *testing.T) {
+	var before, after ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigCreditSpecification(rInt, "unlimited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &before),
+					resource.TestCheckResourceAttr(resName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "unlimited"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceConfigCreditSpecification(rInt, "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "standard"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigCreditSpecification(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resName, "credit_specification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_getPasswordData_falseToTrue(t *testing.T) {
 	var before, after ec2.Instance
 	resName := "aws_instance.foo"
@@ -1578,6 +1656,58 @@ resource "aws_instance" "foo" {
 `, rInt)
 }
 
+func testAccInstanceConfigLaunchTemplate(rInt int, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+	name = "tf-acctest-instance-%d"
+	# us-west-2
+	image_id = "ami-4fccb37f"
+	instance_type = "%s"
+}
+
+resource "aws_instance" "foo" {
+	launch_template {
+		id = "${aws_launch_template.foo.id}"
+		version = "$Latest"
+	}
+}
+`, rInt, instanceType)
+}
+
+func testAccInstanceConfigCreditSpecification(rInt int, cpuCredits string) string {
+	creditSpecification := ""
+	if cpuCredits != "" {
+		creditSpecification = fmt.Sprintf(`
+	credit_specification {
+		cpu_credits = "%s"
+	}
+`, cpuCredits)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_vpc" "my_vpc" {
+	cidr_block = "172.16.0.0/16"
+	tags {
+		Name = "tf-acctest-instance-credit-specification-%d"
+	}
+}
+
+resource "aws_subnet" "my_subnet" {
+	vpc_id = "${aws_vpc.my_vpc.id}"
+	cidr_block = "172.16.20.0/24"
+	availability_zone = "us-west-2a"
+}
+
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-22b9a343" # amzn-ami-2015.03.0.x86_64-hvm-gp2
+	instance_type = "t2.micro"
+	subnet_id = "${aws_subnet.my_subnet.id}"
+%s
+}
+`, rInt, creditSpecification)
+}
+
 const testAccInstanceConfigWithSmallInstanceType = `
 resource "aws_instance" "foo" {
 	# us-west-2
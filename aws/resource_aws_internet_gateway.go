@@ -65,7 +65,7 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 		return errwrap.Wrapf("{{err}}", err)
 	}
 
-	err = SetTags(conn, d)
+	err = SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return err
 	}
@@ -115,7 +115,7 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	conn := meta.(*AWSClient).ec2conn
 
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
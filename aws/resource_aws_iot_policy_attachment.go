@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotPolicyAttachmentCreate,
+		Read:   resourceAwsIotPolicyAttachmentRead,
+		Delete: resourceAwsIotPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	input := &iot.AttachPolicyInput{
+		PolicyName: aws.String(policyName),
+		Target:     aws.String(target),
+	}
+
+	log.Printf("[DEBUG] Attaching IoT Policy: %s", input)
+	if _, err := conn.AttachPolicy(input); err != nil {
+		return fmt.Errorf("Error attaching IoT Policy (%s) to %s: %s", policyName, target, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", policyName, target))
+
+	return resourceAwsIotPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName, target, err := parseIotPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	attached, err := iotPolicyIsAttached(conn, policyName, target)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Policy attachment target (%s) not found, removing from state", target)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing IoT Policies attached to %s: %s", target, err)
+	}
+
+	if !attached {
+		log.Printf("[WARN] IoT Policy (%s) is not attached to %s, removing from state", policyName, target)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy", policyName)
+	d.Set("target", target)
+
+	return nil
+}
+
+func resourceAwsIotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName, target, err := parseIotPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iot.DetachPolicyInput{
+		PolicyName: aws.String(policyName),
+		Target:     aws.String(target),
+	}
+
+	log.Printf("[DEBUG] Detaching IoT Policy: %s", input)
+	if _, err := conn.DetachPolicy(input); err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error detaching IoT Policy (%s) from %s: %s", policyName, target, err)
+	}
+
+	return nil
+}
+
+func iotPolicyIsAttached(conn *iot.IoT, policyName, target string) (bool, error) {
+	input := &iot.ListAttachedPoliciesInput{
+		Target: aws.String(target),
+	}
+
+	for {
+		resp, err := conn.ListAttachedPolicies(input)
+		if err != nil {
+			return false, err
+		}
+
+		for _, policy := range resp.Policies {
+			if aws.StringValue(policy.PolicyName) == policyName {
+				return true, nil
+			}
+		}
+
+		if aws.StringValue(resp.NextMarker) == "" {
+			return false, nil
+		}
+		input.Marker = resp.NextMarker
+	}
+}
+
+// IoT policy names cannot contain colons, so the first colon separates the
+// policy name from the target ARN.
+func parseIotPolicyAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected POLICY-NAME:TARGET-ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseIotPolicyAttachmentID(t *testing.T) {
+	cases := []struct {
+		ID         string
+		PolicyName string
+		Target     string
+		ErrCount   int
+	}{
+		{
+			ID:         "policy:arn:aws:iot:us-west-2:123456789012:cert/abcdef",
+			PolicyName: "policy",
+			Target:     "arn:aws:iot:us-west-2:123456789012:cert/abcdef",
+		},
+		{
+			ID:       "policy",
+			ErrCount: 1,
+		},
+		{
+			ID:       ":arn:aws:iot:us-west-2:123456789012:cert/abcdef",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		policyName, target, err := parseIotPolicyAttachmentID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to error, got: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("expected %q to error", tc.ID)
+			}
+			continue
+		}
+		if policyName != tc.PolicyName || target != tc.Target {
+			t.Fatalf("expected %q to parse as (%q, %q), got (%q, %q)", tc.ID, tc.PolicyName, tc.Target, policyName, target)
+		}
+	}
+}
+
+func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
+	resourceName := "aws_iot_policy_attachment.test"
+	policyName := fmt.Sprintf("tf_acc_policy_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotPolicyAttachmentConfig(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy", policyName),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "aws_iot_certificate.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Policy Attachment ID is set")
+		}
+
+		policyName, target, err := parseIotPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		attached, err := iotPolicyIsAttached(conn, policyName, target)
+		if err != nil {
+			return err
+		}
+
+		if !attached {
+			return fmt.Errorf("IoT Policy (%s) is not attached to %s", policyName, target)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_policy_attachment" {
+			continue
+		}
+
+		policyName, target, err := parseIotPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attached, err := iotPolicyIsAttached(conn, policyName, target)
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if attached {
+			return fmt.Errorf("IoT Policy (%s) is still attached to %s", policyName, target)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotPolicyAttachmentConfig(policyName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_certificate" "test" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+
+resource "aws_iot_policy" "test" {
+  name = "%s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": ["iot:*"],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iot_policy_attachment" "test" {
+  policy = "${aws_iot_policy.test.name}"
+  target = "${aws_iot_certificate.test.arn}"
+}
+`, policyName)
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotRoleAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotRoleAliasCreate,
+		Read:   resourceAwsIotRoleAliasRead,
+		Update: resourceAwsIotRoleAliasUpdate,
+		Delete: resourceAwsIotRoleAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credential_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 3600),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsIotRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	alias := d.Get("alias").(string)
+
+	input := &iot.CreateRoleAliasInput{
+		CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+		RoleAlias:                 aws.String(alias),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IoT Role Alias: %s", input)
+	if _, err := conn.CreateRoleAlias(input); err != nil {
+		return fmt.Errorf("Error creating IoT Role Alias (%s): %s", alias, err)
+	}
+
+	d.SetId(alias)
+
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	resp, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Role Alias (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading IoT Role Alias (%s): %s", d.Id(), err)
+	}
+
+	if resp.RoleAliasDescription == nil {
+		log.Printf("[WARN] IoT Role Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	alias := resp.RoleAliasDescription
+	d.Set("alias", alias.RoleAlias)
+	d.Set("arn", alias.RoleAliasArn)
+	d.Set("credential_duration", alias.CredentialDurationSeconds)
+	d.Set("role_arn", alias.RoleArn)
+
+	return nil
+}
+
+func resourceAwsIotRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	if d.HasChange("credential_duration") || d.HasChange("role_arn") {
+		input := &iot.UpdateRoleAliasInput{
+			CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+			RoleAlias:                 aws.String(d.Id()),
+			RoleArn:                   aws.String(d.Get("role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Role Alias: %s", input)
+		if _, err := conn.UpdateRoleAlias(input); err != nil {
+			return fmt.Errorf("Error updating IoT Role Alias (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	log.Printf("[DEBUG] Deleting IoT Role Alias: %s", d.Id())
+	_, err := conn.DeleteRoleAlias(&iot.DeleteRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Role Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
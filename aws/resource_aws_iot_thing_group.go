@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotThingGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingGroupCreate,
+		Read:   resourceAwsIotThingGroupRead,
+		Update: resourceAwsIotThingGroupUpdate,
+		Delete: resourceAwsIotThingGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	name := d.Get("name").(string)
+
+	input := &iot.CreateThingGroupInput{
+		ThingGroupName:       aws.String(name),
+		ThingGroupProperties: expandIotThingGroupProperties(d),
+	}
+
+	if v, ok := d.GetOk("parent_group_name"); ok {
+		input.ParentGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating IoT Thing Group: %s", input)
+	if _, err := conn.CreateThingGroup(input); err != nil {
+		return fmt.Errorf("Error creating IoT Thing Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotThingGroupRead(d, meta)
+}
+
+func resourceAwsIotThingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	resp, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Thing Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading IoT Thing Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.ThingGroupArn)
+	d.Set("name", resp.ThingGroupName)
+	d.Set("version", resp.Version)
+
+	attributes := map[string]string{}
+	description := ""
+	if props := resp.ThingGroupProperties; props != nil {
+		description = aws.StringValue(props.ThingGroupDescription)
+		if props.AttributePayload != nil {
+			attributes = aws.StringValueMap(props.AttributePayload.Attributes)
+		}
+	}
+	d.Set("description", description)
+	if err := d.Set("attributes", attributes); err != nil {
+		return fmt.Errorf("Error setting attributes: %s", err)
+	}
+
+	parentGroupName := ""
+	if resp.ThingGroupMetadata != nil {
+		parentGroupName = aws.StringValue(resp.ThingGroupMetadata.ParentGroupName)
+	}
+	d.Set("parent_group_name", parentGroupName)
+
+	return nil
+}
+
+func resourceAwsIotThingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	if d.HasChange("attributes") || d.HasChange("description") {
+		input := &iot.UpdateThingGroupInput{
+			ExpectedVersion:      aws.Int64(int64(d.Get("version").(int))),
+			ThingGroupName:       aws.String(d.Id()),
+			ThingGroupProperties: expandIotThingGroupProperties(d),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Thing Group: %s", input)
+		if _, err := conn.UpdateThingGroup(input); err != nil {
+			return fmt.Errorf("Error updating IoT Thing Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotThingGroupRead(d, meta)
+}
+
+func resourceAwsIotThingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	log.Printf("[DEBUG] Deleting IoT Thing Group: %s", d.Id())
+	_, err := conn.DeleteThingGroup(&iot.DeleteThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Thing Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// The full attribute map is always sent with merge disabled so that removed
+// attributes are dropped from the group rather than left behind.
+func expandIotThingGroupProperties(d *schema.ResourceData) *iot.ThingGroupProperties {
+	attributes := map[string]*string{}
+	if v, ok := d.GetOk("attributes"); ok {
+		attributes = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	return &iot.ThingGroupProperties{
+		AttributePayload: &iot.AttributePayload{
+			Attributes: attributes,
+			Merge:      aws.Bool(false),
+		},
+		ThingGroupDescription: aws.String(d.Get("description").(string)),
+	}
+}
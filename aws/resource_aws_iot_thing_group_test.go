@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotThingGroup_basic(t *testing.T) {
+	resourceName := "aws_iot_thing_group.test"
+	rName := fmt.Sprintf("tf_acc_group_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "parent_group_name", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingGroupConfig_full(rName, "first", "42"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.One", "11111"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Answer", "42"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingGroupConfig_full(rName, "second", "differentOne"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Answer", "differentOne"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroup_parent(t *testing.T) {
+	resourceName := "aws_iot_thing_group.child"
+	rName := fmt.Sprintf("tf_acc_group_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfig_parent(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_group_name", "aws_iot_thing_group.parent", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		_, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+			ThingGroupName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIotThingGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_group" {
+			continue
+		}
+
+		_, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+			ThingGroupName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Thing Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccAWSIotThingGroupConfig_full(rName, description, answer string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name        = "%s"
+  description = "%s"
+
+  attributes {
+    One    = "11111"
+    Answer = "%s"
+  }
+}
+`, rName, description, answer)
+}
+
+func testAccAWSIotThingGroupConfig_parent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "parent" {
+  name = "%[1]s_parent"
+}
+
+resource "aws_iot_thing_group" "child" {
+  name              = "%[1]s_child"
+  parent_group_name = "${aws_iot_thing_group.parent.name}"
+}
+`, rName)
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIotThingPrincipalAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingPrincipalAttachmentCreate,
+		Read:   resourceAwsIotThingPrincipalAttachmentRead,
+		Delete: resourceAwsIotThingPrincipalAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"thing": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingPrincipalAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thing := d.Get("thing").(string)
+	principal := d.Get("principal").(string)
+
+	input := &iot.AttachThingPrincipalInput{
+		Principal: aws.String(principal),
+		ThingName: aws.String(thing),
+	}
+
+	log.Printf("[DEBUG] Attaching IoT Thing Principal: %s", input)
+	if _, err := conn.AttachThingPrincipal(input); err != nil {
+		return fmt.Errorf("Error attaching %s to IoT Thing (%s): %s", principal, thing, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", thing, principal))
+
+	return resourceAwsIotThingPrincipalAttachmentRead(d, meta)
+}
+
+func resourceAwsIotThingPrincipalAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thing, principal, err := parseIotThingPrincipalAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.ListThingPrincipals(&iot.ListThingPrincipalsInput{
+		ThingName: aws.String(thing),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Thing (%s) not found, removing from state", thing)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing IoT Thing (%s) principals: %s", thing, err)
+	}
+
+	found := false
+	for _, p := range resp.Principals {
+		if aws.StringValue(p) == principal {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] %s is not attached to IoT Thing (%s), removing from state", principal, thing)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("principal", principal)
+	d.Set("thing", thing)
+
+	return nil
+}
+
+func resourceAwsIotThingPrincipalAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thing, principal, err := parseIotThingPrincipalAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iot.DetachThingPrincipalInput{
+		Principal: aws.String(principal),
+		ThingName: aws.String(thing),
+	}
+
+	log.Printf("[DEBUG] Detaching IoT Thing Principal: %s", input)
+	if _, err := conn.DetachThingPrincipal(input); err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error detaching %s from IoT Thing (%s): %s", principal, thing, err)
+	}
+
+	return nil
+}
+
+// Thing names may contain colons, so a pipe separates the thing name from
+// the principal ARN.
+func parseIotThingPrincipalAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "|", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected THING-NAME|PRINCIPAL-ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseIotThingPrincipalAttachmentID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		Thing     string
+		Principal string
+		ErrCount  int
+	}{
+		{
+			ID:        "thing:with:colons|arn:aws:iot:us-west-2:123456789012:cert/abcdef",
+			Thing:     "thing:with:colons",
+			Principal: "arn:aws:iot:us-west-2:123456789012:cert/abcdef",
+		},
+		{
+			ID:       "thing",
+			ErrCount: 1,
+		},
+		{
+			ID:       "thing|",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		thing, principal, err := parseIotThingPrincipalAttachmentID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to error, got: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("expected %q to error", tc.ID)
+			}
+			continue
+		}
+		if thing != tc.Thing || principal != tc.Principal {
+			t.Fatalf("expected %q to parse as (%q, %q), got (%q, %q)", tc.ID, tc.Thing, tc.Principal, thing, principal)
+		}
+	}
+}
+
+func TestAccAWSIotThingPrincipalAttachment_basic(t *testing.T) {
+	resourceName := "aws_iot_thing_principal_attachment.test"
+	thingName := fmt.Sprintf("tf_acc_thing_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingPrincipalAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingPrincipalAttachmentConfig(thingName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingPrincipalAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "thing", thingName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", "aws_iot_certificate.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingPrincipalAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Principal Attachment ID is set")
+		}
+
+		thing, principal, err := parseIotThingPrincipalAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		resp, err := conn.ListThingPrincipals(&iot.ListThingPrincipalsInput{
+			ThingName: aws.String(thing),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, p := range resp.Principals {
+			if aws.StringValue(p) == principal {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s is not attached to IoT Thing (%s)", principal, thing)
+	}
+}
+
+func testAccCheckAWSIotThingPrincipalAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_principal_attachment" {
+			continue
+		}
+
+		thing, principal, err := parseIotThingPrincipalAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListThingPrincipals(&iot.ListThingPrincipalsInput{
+			ThingName: aws.String(thing),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, p := range resp.Principals {
+			if aws.StringValue(p) == principal {
+				return fmt.Errorf("%s is still attached to IoT Thing (%s)", principal, thing)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingPrincipalAttachmentConfig(thingName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_certificate" "test" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+
+resource "aws_iot_thing" "test" {
+  name = "%s"
+}
+
+resource "aws_iot_thing_principal_attachment" "test" {
+  principal = "${aws_iot_certificate.test.arn}"
+  thing     = "${aws_iot_thing.test.name}"
+}
+`, thingName)
+}
@@ -136,7 +136,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	conn := meta.(*AWSClient).kinesisconn
 
 	d.Partial(true)
-	if err := SetTagsKinesis(conn, d); err != nil {
+	if err := SetTagsKinesis(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
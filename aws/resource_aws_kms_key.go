@@ -92,8 +92,8 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
-		req.Tags = TagsFromMapKMS(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		req.Tags = TagsFromMapKMS(v)
 	}
 
 	var resp *kms.CreateKeyOutput
@@ -234,7 +234,7 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := SetTagsKMS(conn, d, d.Id()); err != nil {
+	if err := SetTagsKMS(conn, d, d.Id(), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -356,8 +356,8 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
-	if v, exists := d.GetOk("tags"); exists {
-		params.Tags = TagsFromMapGeneric(v.(map[string]interface{}))
+	if v := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = TagsFromMapGeneric(v)
 	}
 
 	// IAM changes can take 1 minute to propagate in AWS
@@ -593,7 +593,7 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 	d.Partial(true)
 
 	arn := d.Get("arn").(string)
-	if tagErr := SetTagsLambda(conn, d, arn); tagErr != nil {
+	if tagErr := SetTagsLambda(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); tagErr != nil {
 		return tagErr
 	}
 	d.SetPartial("tags")
@@ -0,0 +1,1255 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLaunchTemplateCreate,
+		Read:   resourceAwsLaunchTemplateRead,
+		Update: resourceAwsLaunchTemplateUpdate,
+		Delete: resourceAwsLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(128 - resource.UniqueIDSuffixLength),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(255),
+			},
+
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"update_default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"iops": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Computed:         true,
+										DiffSuppressFunc: iopsDiffSuppressFunc,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.VolumeTypeStandard,
+											ec2.VolumeTypeIo1,
+											ec2.VolumeTypeGp2,
+											ec2.VolumeTypeSc1,
+											ec2.VolumeTypeSt1,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.name"},
+							ValidateFunc:  validateArn,
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.arn"},
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.MarketTypeSpot,
+							}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": TagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
+					s := v.(string)
+					if !isBase64Encoded([]byte(s)) {
+						errs = append(errs, fmt.Errorf(
+							"%s: must be base64-encoded", name,
+						))
+					}
+					return
+				},
+			},
+
+			"tags": TagsSchema(),
+		},
+	}
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	launchTemplateData, err := buildLaunchTemplateData(d)
+	if err != nil {
+		return err
+	}
+
+	launchTemplateOpts := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: launchTemplateData,
+	}
+
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		launchTemplateOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Launch Template: %s", launchTemplateOpts)
+	resp, err := conn.CreateLaunchTemplate(launchTemplateOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Launch Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.LaunchTemplate.LaunchTemplateId))
+	log.Printf("[INFO] Launch Template ID: %s", d.Id())
+
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Reading launch template %s", d.Id())
+
+	dlt, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") ||
+		isAWSErr(err, "InvalidLaunchTemplateId.Malformed", "") {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error getting Launch Template (%s): %s", d.Id(), err)
+	}
+
+	if dlt == nil || len(dlt.LaunchTemplates) == 0 {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Found launch template %s", d.Id())
+
+	lt := dlt.LaunchTemplates[0]
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("tags", TagsToMap(lt.Tags))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ec2",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("launch-template/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	version := strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10)
+	dltv, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(d.Id()),
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting Launch Template (%s) version %s: %s", d.Id(), version, err)
+	}
+
+	if len(dltv.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("Launch Template (%s) version %s not found", d.Id(), version)
+	}
+
+	log.Printf("[DEBUG] Received launch template version %q (version %s)", d.Id(), version)
+
+	ltv := dltv.LaunchTemplateVersions[0]
+	d.Set("description", ltv.VersionDescription)
+
+	return setLaunchTemplateData(d, ltv.LaunchTemplateData)
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("tags") {
+		if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	// Every change to the launch template data results in a new version.
+	// The remaining attributes are all part of that data, so only skip
+	// creating a version when nothing but the tags or the default version
+	// flag changed.
+	if resourceAwsLaunchTemplateDataHasChange(d) {
+		launchTemplateData, err := buildLaunchTemplateData(d)
+		if err != nil {
+			return err
+		}
+
+		launchTemplateVersionOpts := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: launchTemplateData,
+		}
+
+		if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+			launchTemplateVersionOpts.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Launch Template version: %s", launchTemplateVersionOpts)
+		resp, err := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
+		if err != nil {
+			return fmt.Errorf("Error creating Launch Template (%s) version: %s", d.Id(), err)
+		}
+
+		if d.Get("update_default_version").(bool) {
+			version := strconv.FormatInt(aws.Int64Value(resp.LaunchTemplateVersion.VersionNumber), 10)
+			log.Printf("[DEBUG] Setting Launch Template (%s) default version to %s", d.Id(), version)
+			_, err := conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+				LaunchTemplateId: aws.String(d.Id()),
+				DefaultVersion:   aws.String(version),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating Launch Template (%s) default version: %s", d.Id(), err)
+			}
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Launch Template destroy: %v", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting Launch Template (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Launch Template deleted: %v", d.Id())
+	return nil
+}
+
+var launchTemplateDataAttributes = []string{
+	"description",
+	"block_device_mappings",
+	"credit_specification",
+	"disable_api_termination",
+	"ebs_optimized",
+	"elastic_gpu_specifications",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_market_options",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"vpc_security_group_ids",
+	"tag_specifications",
+	"user_data",
+}
+
+func resourceAwsLaunchTemplateDataHasChange(d *schema.ResourceData) bool {
+	for _, k := range launchTemplateDataAttributes {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func setLaunchTemplateData(d *schema.ResourceData, ltData *ec2.ResponseLaunchTemplateData) error {
+	if ltData == nil {
+		return nil
+	}
+
+	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("ebs_optimized", ltData.EbsOptimized)
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("user_data", ltData.UserData)
+
+	if err := d.Set("security_group_names", flattenStringList(ltData.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_group_names: %s", err)
+	}
+	if err := d.Set("vpc_security_group_ids", flattenStringList(ltData.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+	}
+	if err := d.Set("block_device_mappings", flattenLaunchTemplateBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("error setting block_device_mappings: %s", err)
+	}
+	if err := d.Set("credit_specification", flattenLaunchTemplateCreditSpecification(ltData.CreditSpecification)); err != nil {
+		return fmt.Errorf("error setting credit_specification: %s", err)
+	}
+	if err := d.Set("elastic_gpu_specifications", flattenLaunchTemplateElasticGpuSpecifications(ltData.ElasticGpuSpecifications)); err != nil {
+		return fmt.Errorf("error setting elastic_gpu_specifications: %s", err)
+	}
+	if err := d.Set("iam_instance_profile", flattenLaunchTemplateIamInstanceProfile(ltData.IamInstanceProfile)); err != nil {
+		return fmt.Errorf("error setting iam_instance_profile: %s", err)
+	}
+	if err := d.Set("instance_market_options", flattenLaunchTemplateInstanceMarketOptions(ltData.InstanceMarketOptions)); err != nil {
+		return fmt.Errorf("error setting instance_market_options: %s", err)
+	}
+	if err := d.Set("monitoring", flattenLaunchTemplateMonitoring(ltData.Monitoring)); err != nil {
+		return fmt.Errorf("error setting monitoring: %s", err)
+	}
+	if err := d.Set("network_interfaces", flattenLaunchTemplateNetworkInterfaces(ltData.NetworkInterfaces)); err != nil {
+		return fmt.Errorf("error setting network_interfaces: %s", err)
+	}
+	if err := d.Set("placement", flattenLaunchTemplatePlacement(ltData.Placement)); err != nil {
+		return fmt.Errorf("error setting placement: %s", err)
+	}
+	if err := d.Set("tag_specifications", flattenLaunchTemplateTagSpecifications(ltData.TagSpecifications)); err != nil {
+		return fmt.Errorf("error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("disable_api_termination"); ok {
+		opts.DisableApiTermination = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("ebs_optimized"); ok {
+		opts.EbsOptimized = aws.Bool(v.(bool))
+	}
+
+	if v := d.Get("security_group_names").(*schema.Set); v.Len() > 0 {
+		opts.SecurityGroups = expandStringList(v.List())
+	}
+
+	if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
+		opts.SecurityGroupIds = expandStringList(v.List())
+	}
+
+	if v, ok := d.GetOk("block_device_mappings"); ok {
+		opts.BlockDeviceMappings = expandLaunchTemplateBlockDeviceMappings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok {
+		opts.CreditSpecification = expandLaunchTemplateCreditSpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("elastic_gpu_specifications"); ok {
+		opts.ElasticGpuSpecifications = expandLaunchTemplateElasticGpuSpecifications(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("iam_instance_profile"); ok {
+		opts.IamInstanceProfile = expandLaunchTemplateIamInstanceProfile(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_market_options"); ok {
+		marketOptions, err := expandLaunchTemplateInstanceMarketOptions(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		opts.InstanceMarketOptions = marketOptions
+	}
+
+	if v, ok := d.GetOk("monitoring"); ok {
+		opts.Monitoring = expandLaunchTemplateMonitoring(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_interfaces"); ok {
+		opts.NetworkInterfaces = expandLaunchTemplateNetworkInterfaces(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("placement"); ok {
+		opts.Placement = expandLaunchTemplatePlacement(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_specifications"); ok {
+		opts.TagSpecifications = expandLaunchTemplateTagSpecifications(v.([]interface{}))
+	}
+
+	return opts, nil
+}
+
+func expandLaunchTemplateBlockDeviceMappings(l []interface{}) []*ec2.LaunchTemplateBlockDeviceMappingRequest {
+	mappings := make([]*ec2.LaunchTemplateBlockDeviceMappingRequest, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		mapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{}
+
+		if v, ok := m["device_name"].(string); ok && v != "" {
+			mapping.DeviceName = aws.String(v)
+		}
+		if v, ok := m["no_device"].(string); ok && v != "" {
+			mapping.NoDevice = aws.String(v)
+		}
+		if v, ok := m["virtual_name"].(string); ok && v != "" {
+			mapping.VirtualName = aws.String(v)
+		}
+		if v, ok := m["ebs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mapping.Ebs = expandLaunchTemplateEbsBlockDevice(v[0].(map[string]interface{}))
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+func expandLaunchTemplateEbsBlockDevice(m map[string]interface{}) *ec2.LaunchTemplateEbsBlockDeviceRequest {
+	ebs := &ec2.LaunchTemplateEbsBlockDeviceRequest{
+		DeleteOnTermination: aws.Bool(m["delete_on_termination"].(bool)),
+	}
+
+	if v, ok := m["snapshot_id"].(string); ok && v != "" {
+		ebs.SnapshotId = aws.String(v)
+	} else {
+		// Encryption is inherited from the snapshot when one is given
+		ebs.Encrypted = aws.Bool(m["encrypted"].(bool))
+	}
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		ebs.KmsKeyId = aws.String(v)
+	}
+	if v, ok := m["volume_size"].(int); ok && v != 0 {
+		ebs.VolumeSize = aws.Int64(int64(v))
+	}
+	if v, ok := m["volume_type"].(string); ok && v != "" {
+		ebs.VolumeType = aws.String(v)
+		if v == ec2.VolumeTypeIo1 {
+			if iops, ok := m["iops"].(int); ok && iops > 0 {
+				ebs.Iops = aws.Int64(int64(iops))
+			}
+		}
+	}
+
+	return ebs
+}
+
+func expandLaunchTemplateCreditSpecification(l []interface{}) *ec2.CreditSpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.CreditSpecificationRequest{
+		CpuCredits: aws.String(m["cpu_credits"].(string)),
+	}
+}
+
+func expandLaunchTemplateElasticGpuSpecifications(l []interface{}) []*ec2.ElasticGpuSpecification {
+	specs := make([]*ec2.ElasticGpuSpecification, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		specs = append(specs, &ec2.ElasticGpuSpecification{
+			Type: aws.String(m["type"].(string)),
+		})
+	}
+
+	return specs
+}
+
+func expandLaunchTemplateIamInstanceProfile(l []interface{}) *ec2.LaunchTemplateIamInstanceProfileSpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	profile := &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+
+	if v, ok := m["arn"].(string); ok && v != "" {
+		profile.Arn = aws.String(v)
+	}
+	if v, ok := m["name"].(string); ok && v != "" {
+		profile.Name = aws.String(v)
+	}
+
+	return profile
+}
+
+func expandLaunchTemplateInstanceMarketOptions(l []interface{}) (*ec2.LaunchTemplateInstanceMarketOptionsRequest, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	options := &ec2.LaunchTemplateInstanceMarketOptionsRequest{}
+
+	if v, ok := m["market_type"].(string); ok && v != "" {
+		options.MarketType = aws.String(v)
+	}
+
+	if v, ok := m["spot_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		spotOptions := &ec2.LaunchTemplateSpotMarketOptionsRequest{}
+
+		if v, ok := so["block_duration_minutes"].(int); ok && v != 0 {
+			spotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		if v, ok := so["instance_interruption_behavior"].(string); ok && v != "" {
+			spotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+		if v, ok := so["max_price"].(string); ok && v != "" {
+			spotOptions.MaxPrice = aws.String(v)
+		}
+		if v, ok := so["spot_instance_type"].(string); ok && v != "" {
+			spotOptions.SpotInstanceType = aws.String(v)
+		}
+		if v, ok := so["valid_until"].(string); ok && v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing launch template spot options valid_until: %s", err)
+			}
+			spotOptions.ValidUntil = aws.Time(t)
+		}
+
+		options.SpotOptions = spotOptions
+	}
+
+	return options, nil
+}
+
+func expandLaunchTemplateMonitoring(l []interface{}) *ec2.LaunchTemplatesMonitoringRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.LaunchTemplatesMonitoringRequest{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+}
+
+func expandLaunchTemplateNetworkInterfaces(l []interface{}) []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	networkInterfaces := make([]*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		ni := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			DeviceIndex: aws.Int64(int64(m["device_index"].(int))),
+		}
+
+		if v, ok := m["network_interface_id"].(string); ok && v != "" {
+			// A pre-existing interface brings its own addressing and
+			// security groups, which may not be set alongside it.
+			ni.NetworkInterfaceId = aws.String(v)
+		} else {
+			ni.AssociatePublicIpAddress = aws.Bool(m["associate_public_ip_address"].(bool))
+			ni.DeleteOnTermination = aws.Bool(m["delete_on_termination"].(bool))
+
+			if v, ok := m["description"].(string); ok && v != "" {
+				ni.Description = aws.String(v)
+			}
+			if v, ok := m["subnet_id"].(string); ok && v != "" {
+				ni.SubnetId = aws.String(v)
+			}
+			if v, ok := m["private_ip_address"].(string); ok && v != "" {
+				ni.PrivateIpAddress = aws.String(v)
+			}
+			if v := m["security_groups"].(*schema.Set); v.Len() > 0 {
+				ni.Groups = expandStringList(v.List())
+			}
+
+			if v, ok := m["ipv6_address_count"].(int); ok && v > 0 {
+				ni.Ipv6AddressCount = aws.Int64(int64(v))
+			} else if v := m["ipv6_addresses"].(*schema.Set); v.Len() > 0 {
+				for _, address := range v.List() {
+					ni.Ipv6Addresses = append(ni.Ipv6Addresses, &ec2.InstanceIpv6AddressRequest{
+						Ipv6Address: aws.String(address.(string)),
+					})
+				}
+			}
+
+			if v, ok := m["ipv4_address_count"].(int); ok && v > 0 {
+				ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+			} else if v := m["ipv4_addresses"].(*schema.Set); v.Len() > 0 {
+				for _, address := range v.List() {
+					ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, &ec2.PrivateIpAddressSpecification{
+						Primary:          aws.Bool(false),
+						PrivateIpAddress: aws.String(address.(string)),
+					})
+				}
+			}
+		}
+
+		networkInterfaces = append(networkInterfaces, ni)
+	}
+
+	return networkInterfaces
+}
+
+func expandLaunchTemplatePlacement(l []interface{}) *ec2.LaunchTemplatePlacementRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	placement := &ec2.LaunchTemplatePlacementRequest{}
+
+	if v, ok := m["affinity"].(string); ok && v != "" {
+		placement.Affinity = aws.String(v)
+	}
+	if v, ok := m["availability_zone"].(string); ok && v != "" {
+		placement.AvailabilityZone = aws.String(v)
+	}
+	if v, ok := m["group_name"].(string); ok && v != "" {
+		placement.GroupName = aws.String(v)
+	}
+	if v, ok := m["host_id"].(string); ok && v != "" {
+		placement.HostId = aws.String(v)
+	}
+	if v, ok := m["spread_domain"].(string); ok && v != "" {
+		placement.SpreadDomain = aws.String(v)
+	}
+	if v, ok := m["tenancy"].(string); ok && v != "" {
+		placement.Tenancy = aws.String(v)
+	}
+
+	return placement
+}
+
+func expandLaunchTemplateTagSpecifications(l []interface{}) []*ec2.LaunchTemplateTagSpecificationRequest {
+	specs := make([]*ec2.LaunchTemplateTagSpecificationRequest, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		specs = append(specs, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: aws.String(m["resource_type"].(string)),
+			Tags:         TagsFromMap(m["tags"].(map[string]interface{})),
+		})
+	}
+
+	return specs
+}
+
+func flattenLaunchTemplateBlockDeviceMappings(mappings []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
+	l := make([]interface{}, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		m := map[string]interface{}{
+			"device_name":  aws.StringValue(mapping.DeviceName),
+			"no_device":    aws.StringValue(mapping.NoDevice),
+			"virtual_name": aws.StringValue(mapping.VirtualName),
+		}
+
+		if ebs := mapping.Ebs; ebs != nil {
+			m["ebs"] = []interface{}{
+				map[string]interface{}{
+					"delete_on_termination": aws.BoolValue(ebs.DeleteOnTermination),
+					"encrypted":             aws.BoolValue(ebs.Encrypted),
+					"iops":                  int(aws.Int64Value(ebs.Iops)),
+					"kms_key_id":            aws.StringValue(ebs.KmsKeyId),
+					"snapshot_id":           aws.StringValue(ebs.SnapshotId),
+					"volume_size":           int(aws.Int64Value(ebs.VolumeSize)),
+					"volume_type":           aws.StringValue(ebs.VolumeType),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenLaunchTemplateCreditSpecification(cs *ec2.CreditSpecification) []interface{} {
+	if cs == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu_credits": aws.StringValue(cs.CpuCredits),
+		},
+	}
+}
+
+func flattenLaunchTemplateElasticGpuSpecifications(specs []*ec2.ElasticGpuSpecificationResponse) []interface{} {
+	l := make([]interface{}, 0, len(specs))
+
+	for _, spec := range specs {
+		l = append(l, map[string]interface{}{
+			"type": aws.StringValue(spec.Type),
+		})
+	}
+
+	return l
+}
+
+func flattenLaunchTemplateIamInstanceProfile(ip *ec2.LaunchTemplateIamInstanceProfileSpecification) []interface{} {
+	if ip == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"arn":  aws.StringValue(ip.Arn),
+			"name": aws.StringValue(ip.Name),
+		},
+	}
+}
+
+func flattenLaunchTemplateInstanceMarketOptions(imo *ec2.LaunchTemplateInstanceMarketOptions) []interface{} {
+	if imo == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"market_type": aws.StringValue(imo.MarketType),
+	}
+
+	if so := imo.SpotOptions; so != nil {
+		spotOptions := map[string]interface{}{
+			"block_duration_minutes":         int(aws.Int64Value(so.BlockDurationMinutes)),
+			"instance_interruption_behavior": aws.StringValue(so.InstanceInterruptionBehavior),
+			"max_price":                      aws.StringValue(so.MaxPrice),
+			"spot_instance_type":             aws.StringValue(so.SpotInstanceType),
+		}
+		if so.ValidUntil != nil {
+			spotOptions["valid_until"] = aws.TimeValue(so.ValidUntil).Format(time.RFC3339)
+		}
+		m["spot_options"] = []interface{}{spotOptions}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenLaunchTemplateMonitoring(monitoring *ec2.LaunchTemplatesMonitoring) []interface{} {
+	if monitoring == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": aws.BoolValue(monitoring.Enabled),
+		},
+	}
+}
+
+func flattenLaunchTemplateNetworkInterfaces(nis []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
+	l := make([]interface{}, 0, len(nis))
+
+	for _, ni := range nis {
+		ipv6Addresses := make([]interface{}, 0, len(ni.Ipv6Addresses))
+		for _, address := range ni.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(address.Ipv6Address))
+		}
+
+		ipv4Addresses := make([]interface{}, 0, len(ni.PrivateIpAddresses))
+		for _, address := range ni.PrivateIpAddresses {
+			if aws.BoolValue(address.Primary) {
+				continue
+			}
+			ipv4Addresses = append(ipv4Addresses, aws.StringValue(address.PrivateIpAddress))
+		}
+
+		l = append(l, map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(ni.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(ni.DeleteOnTermination),
+			"description":                 aws.StringValue(ni.Description),
+			"device_index":                int(aws.Int64Value(ni.DeviceIndex)),
+			"security_groups":             schema.NewSet(schema.HashString, flattenStringList(ni.Groups)),
+			"ipv6_address_count":          int(aws.Int64Value(ni.Ipv6AddressCount)),
+			"ipv6_addresses":              schema.NewSet(schema.HashString, ipv6Addresses),
+			"network_interface_id":        aws.StringValue(ni.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(ni.PrivateIpAddress),
+			"ipv4_address_count":          int(aws.Int64Value(ni.SecondaryPrivateIpAddressCount)),
+			"ipv4_addresses":              schema.NewSet(schema.HashString, ipv4Addresses),
+			"subnet_id":                   aws.StringValue(ni.SubnetId),
+		})
+	}
+
+	return l
+}
+
+func flattenLaunchTemplatePlacement(p *ec2.LaunchTemplatePlacement) []interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"affinity":          aws.StringValue(p.Affinity),
+			"availability_zone": aws.StringValue(p.AvailabilityZone),
+			"group_name":        aws.StringValue(p.GroupName),
+			"host_id":           aws.StringValue(p.HostId),
+			"spread_domain":     aws.StringValue(p.SpreadDomain),
+			"tenancy":           aws.StringValue(p.Tenancy),
+		},
+	}
+}
+
+func flattenLaunchTemplateTagSpecifications(specs []*ec2.LaunchTemplateTagSpecification) []interface{} {
+	l := make([]interface{}, 0, len(specs))
+
+	for _, spec := range specs {
+		l = append(l, map[string]interface{}{
+			"resource_type": aws.StringValue(spec.ResourceType),
+			"tags":          TagsToMap(spec.Tags),
+		})
+	}
+
+	return l
+}
@@ -212,7 +212,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: TagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: TagsFromMapELBv2(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -324,7 +324,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
 	if !d.IsNewResource() {
-		if err := SetElbV2Tags(elbconn, d); err != nil {
+		if err := SetElbV2Tags(elbconn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return errwrap.Wrapf("Error Modifying Tags on ALB: {{err}}", err)
 		}
 	}
@@ -0,0 +1,393 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotCreate,
+		Read:   resourceAwsLexBotRead,
+		Update: resourceAwsLexBotUpdate,
+		Delete: resourceAwsLexBotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexBotImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// Publishing a new numbered version changes the computed version
+			return lexSetNewComputedVersion(diff, []string{
+				"abort_statement",
+				"child_directed",
+				"clarification_prompt",
+				"description",
+				"idle_session_ttl_in_seconds",
+				"intent",
+				"voice_id",
+			})
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abort_statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"clarification_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName(1, 100),
+						},
+						"intent_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexVersion,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelbuildingservice.LocaleEnUs,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.LocaleDeDe,
+					lexmodelbuildingservice.LocaleEnGb,
+					lexmodelbuildingservice.LocaleEnUs,
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName(2, 50),
+			},
+			"process_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.ProcessBehaviorSave,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ProcessBehaviorBuild,
+					lexmodelbuildingservice.ProcessBehaviorSave,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexBotInput(d)
+	input.Locale = aws.String(d.Get("locale").(string))
+	input.Name = aws.String(name)
+
+	log.Printf("[DEBUG] Creating Lex Bot: %s", input)
+	if _, err := conn.PutBot(input); err != nil {
+		return fmt.Errorf("Error creating Lex Bot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Get("process_behavior").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(d.Id()),
+		VersionOrAlias: aws.String(LexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex Bot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	version, err := getLatestLexBotVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Lex Bot (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("child_directed", resp.ChildDirected)
+	d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("failure_reason", resp.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", resp.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", aws.TimeValue(resp.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", resp.Locale)
+	d.Set("name", resp.Name)
+	d.Set("status", resp.Status)
+	d.Set("version", version)
+	d.Set("voice_id", resp.VoiceId)
+
+	if err := d.Set("abort_statement", flattenLexStatement(resp.AbortStatement)); err != nil {
+		return fmt.Errorf("Error setting abort_statement: %s", err)
+	}
+	if err := d.Set("clarification_prompt", flattenLexPrompt(resp.ClarificationPrompt)); err != nil {
+		return fmt.Errorf("Error setting clarification_prompt: %s", err)
+	}
+	if err := d.Set("intent", flattenLexIntents(resp.Intents)); err != nil {
+		return fmt.Errorf("Error setting intent: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexBotInput(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+	input.Locale = aws.String(d.Get("locale").(string))
+	input.Name = aws.String(d.Id())
+
+	log.Printf("[DEBUG] Updating Lex Bot: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Get("process_behavior").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteBotInput{
+		Name: aws.String(d.Id()),
+	}
+
+	// The bot cannot be deleted while an alias still references it
+	log.Printf("[DEBUG] Deleting Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") ||
+				isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexBotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// process_behavior is not returned by the API
+	d.Set("process_behavior", lexmodelbuildingservice.ProcessBehaviorSave)
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, name, processBehavior string, timeout time.Duration) error {
+	if processBehavior != lexmodelbuildingservice.ProcessBehaviorBuild {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelbuildingservice.StatusBuilding},
+		Target:  []string{lexmodelbuildingservice.StatusReady},
+		Refresh: lexBotStatusRefreshFunc(conn, name),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func lexBotStatusRefreshFunc(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.Status)
+		if status == lexmodelbuildingservice.StatusFailed {
+			return resp, status, fmt.Errorf("%s", aws.StringValue(resp.FailureReason))
+		}
+
+		return resp, status, nil
+	}
+}
+
+func getLatestLexBotVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+
+	var versions []string
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			versions = append(versions, aws.StringValue(bot.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return getLatestLexVersion(versions), nil
+}
+
+func expandLexBotInput(d *schema.ResourceData) *lexmodelbuildingservice.PutBotInput {
+	input := &lexmodelbuildingservice.PutBotInput{
+		AbortStatement:          expandLexStatement(d.Get("abort_statement").([]interface{})),
+		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
+		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt").([]interface{})),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		Description:             aws.String(d.Get("description").(string)),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set).List()),
+		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
+	}
+
+	if v, ok := d.GetOk("voice_id"); ok {
+		input.VoiceId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandLexIntents(rawValues []interface{}) []*lexmodelbuildingservice.Intent {
+	intents := make([]*lexmodelbuildingservice.Intent, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		intents = append(intents, &lexmodelbuildingservice.Intent{
+			IntentName:    aws.String(value["intent_name"].(string)),
+			IntentVersion: aws.String(value["intent_version"].(string)),
+		})
+	}
+
+	return intents
+}
+
+func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(intents))
+
+	for _, intent := range intents {
+		result = append(result, map[string]interface{}{
+			"intent_name":    aws.StringValue(intent.IntentName),
+			"intent_version": aws.StringValue(intent.IntentVersion),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	var v lexmodelbuildingservice.GetBotAliasOutput
+	resourceName := "aws_lex_bot_alias.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				// Changing the bot publishes a new version, which the alias follows
+				Config: testAccAwsLexBotAliasConfig(rName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotAliasExists(n string, v *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot Alias ID is set")
+		}
+
+		botName, name, err := resourceAwsLexBotAliasParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		resp, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		botName, name, err := resourceAwsLexBotAliasParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot Alias %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotAliasConfig(rName string, idleSessionTTL int) string {
+	return testAccAwsLexBotConfig(rName, idleSessionTTL) + fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "${aws_lex_bot.test.version}"
+  name        = "%s"
+}
+`, rName)
+}
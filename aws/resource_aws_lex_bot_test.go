@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	var v lexmodelbuildingservice.GetBotOutput
+	resourceName := "aws_lex_bot.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "abort_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", "BUILD"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				Config: testAccAwsLexBotConfig(rName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version", "process_behavior"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotExists(n string, v *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotConfig_intent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name           = "%s"
+  create_version = true
+
+  sample_utterances = [
+    "I would like to pick up flowers",
+  ]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexBotConfig(rName string, idleSessionTTL int) string {
+	return testAccAwsLexBotConfig_intent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  name                        = "%s"
+  child_directed              = false
+  create_version              = true
+  idle_session_ttl_in_seconds = %d
+  process_behavior            = "BUILD"
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, idleSessionTTL)
+}
@@ -0,0 +1,506 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// Publishing a new numbered version changes the computed version
+			return lexSetNewComputedVersion(diff, []string{
+				"conclusion_statement",
+				"confirmation_prompt",
+				"description",
+				"dialog_code_hook",
+				"follow_up_prompt",
+				"fulfillment_activity",
+				"parent_intent_signature",
+				"rejection_statement",
+				"sample_utterances",
+				"slot",
+			})
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"follow_up_prompt"},
+				Elem:          lexStatementResource,
+			},
+			"confirmation_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexCodeHookResource,
+			},
+			"follow_up_prompt": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"conclusion_statement"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+						"rejection_statement": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem:     lexStatementResource,
+						},
+					},
+				},
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexCodeHookResource,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+								lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName(1, 100),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName(1, 100),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"response_card": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50000),
+						},
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.SlotConstraintOptional,
+								lexmodelbuildingservice.SlotConstraintRequired,
+							}, false),
+						},
+						"slot_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"slot_type_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateLexVersion,
+						},
+						"value_elicitation_prompt": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexIntentInput(d)
+	input.Name = aws.String(name)
+
+	log.Printf("[DEBUG] Creating Lex Intent: %s", input)
+	if _, err := conn.PutIntent(input); err != nil {
+		return fmt.Errorf("Error creating Lex Intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(LexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex Intent (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	version, err := getLatestLexIntentVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Lex Intent (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("last_updated_date", aws.TimeValue(resp.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", resp.Name)
+	d.Set("parent_intent_signature", resp.ParentIntentSignature)
+	d.Set("version", version)
+
+	if err := d.Set("conclusion_statement", flattenLexStatement(resp.ConclusionStatement)); err != nil {
+		return fmt.Errorf("Error setting conclusion_statement: %s", err)
+	}
+	if err := d.Set("confirmation_prompt", flattenLexPrompt(resp.ConfirmationPrompt)); err != nil {
+		return fmt.Errorf("Error setting confirmation_prompt: %s", err)
+	}
+	if err := d.Set("dialog_code_hook", flattenLexCodeHook(resp.DialogCodeHook)); err != nil {
+		return fmt.Errorf("Error setting dialog_code_hook: %s", err)
+	}
+	if err := d.Set("follow_up_prompt", flattenLexFollowUpPrompt(resp.FollowUpPrompt)); err != nil {
+		return fmt.Errorf("Error setting follow_up_prompt: %s", err)
+	}
+	if err := d.Set("fulfillment_activity", flattenLexFulfillmentActivity(resp.FulfillmentActivity)); err != nil {
+		return fmt.Errorf("Error setting fulfillment_activity: %s", err)
+	}
+	if err := d.Set("rejection_statement", flattenLexStatement(resp.RejectionStatement)); err != nil {
+		return fmt.Errorf("Error setting rejection_statement: %s", err)
+	}
+	if err := d.Set("sample_utterances", flattenStringList(resp.SampleUtterances)); err != nil {
+		return fmt.Errorf("Error setting sample_utterances: %s", err)
+	}
+	if err := d.Set("slot", flattenLexSlots(resp.Slots)); err != nil {
+		return fmt.Errorf("Error setting slot: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexIntentInput(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+	input.Name = aws.String(d.Id())
+
+	log.Printf("[DEBUG] Updating Lex Intent: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteIntentInput{
+		Name: aws.String(d.Id()),
+	}
+
+	// The intent cannot be deleted while a bot still references it
+	log.Printf("[DEBUG] Deleting Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteIntent(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") ||
+				isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getLatestLexIntentVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+
+	var versions []string
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			versions = append(versions, aws.StringValue(intent.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return getLatestLexVersion(versions), nil
+}
+
+func expandLexIntentInput(d *schema.ResourceData) *lexmodelbuildingservice.PutIntentInput {
+	input := &lexmodelbuildingservice.PutIntentInput{
+		ConclusionStatement: expandLexStatement(d.Get("conclusion_statement").([]interface{})),
+		ConfirmationPrompt:  expandLexPrompt(d.Get("confirmation_prompt").([]interface{})),
+		CreateVersion:       aws.Bool(d.Get("create_version").(bool)),
+		Description:         aws.String(d.Get("description").(string)),
+		DialogCodeHook:      expandLexCodeHook(d.Get("dialog_code_hook").([]interface{})),
+		FollowUpPrompt:      expandLexFollowUpPrompt(d.Get("follow_up_prompt").([]interface{})),
+		FulfillmentActivity: expandLexFulfillmentActivity(d.Get("fulfillment_activity").([]interface{})),
+		RejectionStatement:  expandLexStatement(d.Get("rejection_statement").([]interface{})),
+		SampleUtterances:    expandStringList(d.Get("sample_utterances").(*schema.Set).List()),
+		Slots:               expandLexSlots(d.Get("slot").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandLexFollowUpPrompt(l []interface{}) *lexmodelbuildingservice.FollowUpPrompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FollowUpPrompt{
+		Prompt:             expandLexPrompt(m["prompt"].([]interface{})),
+		RejectionStatement: expandLexStatement(m["rejection_statement"].([]interface{})),
+	}
+}
+
+func flattenLexFollowUpPrompt(followUp *lexmodelbuildingservice.FollowUpPrompt) []map[string]interface{} {
+	if followUp == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"prompt":              flattenLexPrompt(followUp.Prompt),
+		"rejection_statement": flattenLexStatement(followUp.RejectionStatement),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandLexFulfillmentActivity(l []interface{}) *lexmodelbuildingservice.FulfillmentActivity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FulfillmentActivity{
+		CodeHook: expandLexCodeHook(m["code_hook"].([]interface{})),
+		Type:     aws.String(m["type"].(string)),
+	}
+}
+
+func flattenLexFulfillmentActivity(activity *lexmodelbuildingservice.FulfillmentActivity) []map[string]interface{} {
+	if activity == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"code_hook": flattenLexCodeHook(activity.CodeHook),
+		"type":      aws.StringValue(activity.Type),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandLexSlots(rawValues []interface{}) []*lexmodelbuildingservice.Slot {
+	slots := make([]*lexmodelbuildingservice.Slot, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		slot := &lexmodelbuildingservice.Slot{
+			Description:            aws.String(value["description"].(string)),
+			Name:                   aws.String(value["name"].(string)),
+			Priority:               aws.Int64(int64(value["priority"].(int))),
+			SampleUtterances:       expandStringList(value["sample_utterances"].([]interface{})),
+			SlotConstraint:         aws.String(value["slot_constraint"].(string)),
+			SlotType:               aws.String(value["slot_type"].(string)),
+			ValueElicitationPrompt: expandLexPrompt(value["value_elicitation_prompt"].([]interface{})),
+		}
+
+		if v, ok := value["response_card"].(string); ok && v != "" {
+			slot.ResponseCard = aws.String(v)
+		}
+
+		if v, ok := value["slot_type_version"].(string); ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v)
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(slots))
+
+	for _, slot := range slots {
+		m := map[string]interface{}{
+			"description":              aws.StringValue(slot.Description),
+			"name":                     aws.StringValue(slot.Name),
+			"priority":                 int(aws.Int64Value(slot.Priority)),
+			"sample_utterances":        flattenStringList(slot.SampleUtterances),
+			"slot_constraint":          aws.StringValue(slot.SlotConstraint),
+			"slot_type":                aws.StringValue(slot.SlotType),
+			"slot_type_version":        aws.StringValue(slot.SlotTypeVersion),
+			"value_elicitation_prompt": flattenLexPrompt(slot.ValueElicitationPrompt),
+		}
+
+		if slot.ResponseCard != nil {
+			m["response_card"] = aws.StringValue(slot.ResponseCard)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
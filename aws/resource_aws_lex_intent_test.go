@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	var v lexmodelbuildingservice.GetIntentOutput
+	resourceName := "aws_lex_intent.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfig(rName, "I would like to pick up flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", "ReturnIntent"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				Config: testAccAwsLexIntentConfig(rName, "I would like to order some flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexIntentExists(n string, v *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Intent ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex Intent %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexIntentConfig(rName, utterance string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = "%[1]s"
+  create_version = true
+
+  enumeration_value {
+    value = "lilies"
+  }
+
+  enumeration_value {
+    value = "tulips"
+  }
+}
+
+resource "aws_lex_intent" "test" {
+  name           = "%[1]s"
+  create_version = true
+
+  sample_utterances = [
+    "I would like to place an order",
+    "%[2]s",
+  ]
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  slot {
+    name              = "FlowerType"
+    description       = "The type of flowers to pick up"
+    priority          = 1
+    slot_constraint   = "Required"
+    slot_type         = "${aws_lex_slot_type.test.name}"
+    slot_type_version = "${aws_lex_slot_type.test.version}"
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName, utterance)
+}
@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexSlotTypeCreate,
+		Read:   resourceAwsLexSlotTypeRead,
+		Update: resourceAwsLexSlotTypeUpdate,
+		Delete: resourceAwsLexSlotTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// Publishing a new numbered version changes the computed version
+			return lexSetNewComputedVersion(diff, []string{
+				"description",
+				"enumeration_value",
+				"value_selection_strategy",
+			})
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName(1, 100),
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+					lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution,
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		CreateVersion:          aws.Bool(d.Get("create_version").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Slot Type: %s", input)
+	if _, err := conn.PutSlotType(input); err != nil {
+		return fmt.Errorf("Error creating Lex Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(LexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex Slot Type (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	version, err := getLatestLexSlotTypeVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Lex Slot Type (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("last_updated_date", aws.TimeValue(resp.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", resp.Name)
+	d.Set("value_selection_strategy", resp.ValueSelectionStrategy)
+	d.Set("version", version)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(resp.EnumerationValues)); err != nil {
+		return fmt.Errorf("Error setting enumeration_value: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Checksum:               aws.String(d.Get("checksum").(string)),
+		CreateVersion:          aws.Bool(d.Get("create_version").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(d.Id()),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Lex Slot Type: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutSlotType(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteSlotTypeInput{
+		Name: aws.String(d.Id()),
+	}
+
+	// The slot type cannot be deleted while an intent still references it
+	log.Printf("[DEBUG] Deleting Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteSlotType(input)
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") ||
+				isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getLatestLexSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetSlotTypeVersionsInput{
+		Name: aws.String(name),
+	}
+
+	var versions []string
+	err := conn.GetSlotTypeVersionsPages(input, func(page *lexmodelbuildingservice.GetSlotTypeVersionsOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			versions = append(versions, aws.StringValue(slotType.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return getLatestLexVersion(versions), nil
+}
+
+func expandLexEnumerationValues(rawValues []interface{}) []*lexmodelbuildingservice.EnumerationValue {
+	enums := make([]*lexmodelbuildingservice.EnumerationValue, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		enums = append(enums, &lexmodelbuildingservice.EnumerationValue{
+			Synonyms: expandStringList(value["synonyms"].(*schema.Set).List()),
+			Value:    aws.String(value["value"].(string)),
+		})
+	}
+
+	return enums
+}
+
+func flattenLexEnumerationValues(values []*lexmodelbuildingservice.EnumerationValue) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, map[string]interface{}{
+			"synonyms": flattenStringList(value.Synonyms),
+			"value":    aws.StringValue(value.Value),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	var v lexmodelbuildingservice.GetSlotTypeOutput
+	resourceName := "aws_lex_slot_type.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "Types of flowers to pick up"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to pick up"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", "ORIGINAL_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+				),
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "Kinds of flowers to pick up"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Kinds of flowers to pick up"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexSlotTypeExists(n string, v *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Slot Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(LexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex Slot Type %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexSlotTypeConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = "%s"
+  description    = "%s"
+  create_version = true
+
+  enumeration_value {
+    value    = "lilies"
+    synonyms = ["Lirium", "Martagon"]
+  }
+
+  enumeration_value {
+    value    = "tulips"
+    synonyms = ["Eduardoregelia", "Podonix"]
+  }
+}
+`, rName, description)
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMediaPackageChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaPackageChannelCreate,
+		Read:   resourceAwsMediaPackageChannelRead,
+		Update: resourceAwsMediaPackageChannelUpdate,
+		Delete: resourceAwsMediaPackageChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w-]+$`), "must only contain alphanumeric characters, dashes or underscores"),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hls_ingest": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingest_endpoints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password": {
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	input := &mediapackage.CreateChannelInput{
+		Id: aws.String(d.Get("channel_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaPackage Channel: %s", input)
+	resp, err := conn.CreateChannel(input)
+	if err != nil {
+		return fmt.Errorf("Error creating MediaPackage Channel: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Id))
+
+	return resourceAwsMediaPackageChannelRead(d, meta)
+}
+
+func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	resp, err := conn.DescribeChannel(&mediapackage.DescribeChannelInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaPackage Channel (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading MediaPackage Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.Arn)
+	d.Set("channel_id", resp.Id)
+	d.Set("description", resp.Description)
+
+	if err := d.Set("hls_ingest", flattenMediaPackageHlsIngest(resp.HlsIngest)); err != nil {
+		return fmt.Errorf("Error setting hls_ingest: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	input := &mediapackage.UpdateChannelInput{
+		Id:          aws.String(d.Id()),
+		Description: aws.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating MediaPackage Channel: %s", input)
+	if _, err := conn.UpdateChannel(input); err != nil {
+		return fmt.Errorf("Error updating MediaPackage Channel (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaPackageChannelRead(d, meta)
+}
+
+func resourceAwsMediaPackageChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	log.Printf("[DEBUG] Deleting MediaPackage Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&mediapackage.DeleteChannelInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaPackage Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenMediaPackageHlsIngest(h *mediapackage.HlsIngest) []map[string]interface{} {
+	if h == nil {
+		return []map[string]interface{}{}
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(h.IngestEndpoints))
+	for _, e := range h.IngestEndpoints {
+		endpoints = append(endpoints, map[string]interface{}{
+			"password": aws.StringValue(e.Password),
+			"url":      aws.StringValue(e.Url),
+			"username": aws.StringValue(e.Username),
+		})
+	}
+
+	return []map[string]interface{}{
+		{
+			"ingest_endpoints": endpoints,
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaPackageChannel_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_media_package_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaPackageChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaPackageChannelConfig(rName, "Terraform Acceptance Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaPackageChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test"),
+					resource.TestCheckResourceAttr(resourceName, "hls_ingest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_ingest.0.ingest_endpoints.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_ingest.0.ingest_endpoints.0.url"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_ingest.0.ingest_endpoints.0.username"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccMediaPackageChannelConfig(rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaPackageChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaPackageChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_package_channel" {
+			continue
+		}
+
+		_, err := conn.DescribeChannel(&mediapackage.DescribeChannelInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("MediaPackage Channel %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaPackageChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaPackage Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+		_, err := conn.DescribeChannel(&mediapackage.DescribeChannelInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccMediaPackageChannelConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id  = %q
+  description = %q
+}
+`, rName, description)
+}
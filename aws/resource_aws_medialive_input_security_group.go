@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"whitelist_cidrs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_cidrs").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	resp, err := conn.CreateInputSecurityGroup(input)
+	if err != nil {
+		return fmt.Errorf("Error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.State) == medialive.InputSecurityGroupStateDeleted {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resp.Arn)
+
+	if err := d.Set("inputs", flattenStringList(resp.Inputs)); err != nil {
+		return fmt.Errorf("Error setting inputs: %s", err)
+	}
+
+	if err := d.Set("whitelist_cidrs", flattenMediaLiveInputWhitelistRules(resp.WhitelistRules)); err != nil {
+		return fmt.Errorf("Error setting whitelist_cidrs: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.UpdateInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+		WhitelistRules:       expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_cidrs").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+	if _, err := conn.UpdateInputSecurityGroup(input); err != nil {
+		return fmt.Errorf("Error updating MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target: []string{
+			medialive.InputSecurityGroupStateIdle,
+			medialive.InputSecurityGroupStateInUse,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+				InputSecurityGroupId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp, aws.StringValue(resp.State), nil
+		},
+		Timeout:    5 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for MediaLive Input Security Group (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputWhitelistRuleCidrs(s *schema.Set) []*medialive.InputWhitelistRuleCidr {
+	rules := make([]*medialive.InputWhitelistRuleCidr, 0, s.Len())
+	for _, cidr := range s.List() {
+		rules = append(rules, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(cidr.(string)),
+		})
+	}
+	return rules
+}
+
+func flattenMediaLiveInputWhitelistRules(rules []*medialive.InputWhitelistRule) []string {
+	cidrs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		cidrs = append(cidrs, aws.StringValue(rule.Cidr))
+	}
+	return cidrs
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaLiveInputSecurityGroup_basic(t *testing.T) {
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputSecurityGroupConfig(`"10.0.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccMediaLiveInputSecurityGroupConfig(`"10.0.0.0/16", "192.168.0.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidrs.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveInputSecurityGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input_security_group" {
+			continue
+		}
+
+		resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.State) != medialive.InputSecurityGroupStateDeleted {
+			return fmt.Errorf("MediaLive Input Security Group %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveInputSecurityGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input Security Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+		_, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccMediaLiveInputSecurityGroupConfig(cidrs string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_cidrs = [%s]
+}
+`, cidrs)
+}
@@ -540,7 +540,7 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
 	}
 
-	if tagErr := SetTagsOpsworks(client, d, arn.String()); tagErr != nil {
+	if tagErr := SetTagsOpsworks(client, d, arn.String(), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); tagErr != nil {
 		return tagErr
 	}
 
@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -14,6 +16,7 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsOrganizationCreate,
 		Read:   resourceAwsOrganizationsOrganizationRead,
+		Update: resourceAwsOrganizationsOrganizationUpdate,
 		Delete: resourceAwsOrganizationsOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -36,6 +39,23 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"aws_service_access_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"enabled_policy_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						organizations.PolicyTypeServiceControlPolicy,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
 			"feature_set": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -46,6 +66,42 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 					organizations.OrganizationFeatureSetConsolidatedBilling,
 				}, true),
 			},
+			"roots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -66,6 +122,27 @@ func resourceAwsOrganizationsOrganizationCreate(d *schema.ResourceData, meta int
 	org := resp.Organization
 	d.SetId(*org.Id)
 
+	for _, principal := range d.Get("aws_service_access_principals").(*schema.Set).List() {
+		if err := enableOrganizationsAwsServiceAccess(conn, principal.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("enabled_policy_types").(*schema.Set); v.Len() > 0 {
+		roots, err := listOrganizationsRoots(conn)
+		if err != nil {
+			return fmt.Errorf("Error listing Organization (%s) roots: %s", d.Id(), err)
+		}
+
+		for _, root := range roots {
+			for _, policyType := range v.List() {
+				if err := enableOrganizationsPolicyType(conn, policyType.(string), aws.StringValue(root.Id)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return resourceAwsOrganizationsOrganizationRead(d, meta)
 }
 
@@ -88,9 +165,105 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 	d.Set("master_account_arn", org.Organization.MasterAccountArn)
 	d.Set("master_account_email", org.Organization.MasterAccountEmail)
 	d.Set("master_account_id", org.Organization.MasterAccountId)
+
+	var enabledServicePrincipals []interface{}
+	// ListAWSServiceAccessForOrganization is only available with all features enabled
+	if aws.StringValue(org.Organization.FeatureSet) == organizations.OrganizationFeatureSetAll {
+		err = conn.ListAWSServiceAccessForOrganizationPages(&organizations.ListAWSServiceAccessForOrganizationInput{}, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+			for _, principal := range page.EnabledServicePrincipals {
+				enabledServicePrincipals = append(enabledServicePrincipals, aws.StringValue(principal.ServicePrincipal))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing AWS service principals for Organization (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("aws_service_access_principals", schema.NewSet(schema.HashString, enabledServicePrincipals)); err != nil {
+		return fmt.Errorf("Error setting aws_service_access_principals: %s", err)
+	}
+
+	roots, err := listOrganizationsRoots(conn)
+	if err != nil {
+		return fmt.Errorf("Error listing Organization (%s) roots: %s", d.Id(), err)
+	}
+
+	var enabledPolicyTypes []interface{}
+	for _, root := range roots {
+		for _, policyType := range root.PolicyTypes {
+			if aws.StringValue(policyType.Status) == organizations.PolicyTypeStatusEnabled {
+				enabledPolicyTypes = append(enabledPolicyTypes, aws.StringValue(policyType.Type))
+			}
+		}
+	}
+
+	if err := d.Set("enabled_policy_types", schema.NewSet(schema.HashString, enabledPolicyTypes)); err != nil {
+		return fmt.Errorf("Error setting enabled_policy_types: %s", err)
+	}
+
+	if err := d.Set("roots", flattenOrganizationsRoots(roots)); err != nil {
+		return fmt.Errorf("Error setting roots: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsOrganizationsOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("aws_service_access_principals") {
+		o, n := d.GetChange("aws_service_access_principals")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, principal := range os.Difference(ns).List() {
+			log.Printf("[DEBUG] Disabling AWS Service Access in Organization: %s", principal)
+			_, err := conn.DisableAWSServiceAccess(&organizations.DisableAWSServiceAccessInput{
+				ServicePrincipal: aws.String(principal.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error disabling AWS Service Access (%s) in Organization: %s", principal, err)
+			}
+		}
+
+		for _, principal := range ns.Difference(os).List() {
+			if err := enableOrganizationsAwsServiceAccess(conn, principal.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("enabled_policy_types") {
+		o, n := d.GetChange("enabled_policy_types")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		roots, err := listOrganizationsRoots(conn)
+		if err != nil {
+			return fmt.Errorf("Error listing Organization (%s) roots: %s", d.Id(), err)
+		}
+
+		for _, root := range roots {
+			rootID := aws.StringValue(root.Id)
+
+			for _, policyType := range os.Difference(ns).List() {
+				if err := disableOrganizationsPolicyType(conn, policyType.(string), rootID); err != nil {
+					return err
+				}
+			}
+
+			for _, policyType := range ns.Difference(os).List() {
+				if err := enableOrganizationsPolicyType(conn, policyType.(string), rootID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationRead(d, meta)
+}
+
 func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
@@ -103,3 +276,129 @@ func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func listOrganizationsRoots(conn *organizations.Organizations) ([]*organizations.Root, error) {
+	var roots []*organizations.Root
+
+	err := conn.ListRootsPages(&organizations.ListRootsInput{}, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		roots = append(roots, page.Roots...)
+		return !lastPage
+	})
+
+	return roots, err
+}
+
+func enableOrganizationsAwsServiceAccess(conn *organizations.Organizations, principal string) error {
+	log.Printf("[DEBUG] Enabling AWS Service Access in Organization: %s", principal)
+	_, err := conn.EnableAWSServiceAccess(&organizations.EnableAWSServiceAccessInput{
+		ServicePrincipal: aws.String(principal),
+	})
+	if err != nil {
+		return fmt.Errorf("Error enabling AWS Service Access (%s) in Organization: %s", principal, err)
+	}
+
+	return nil
+}
+
+func enableOrganizationsPolicyType(conn *organizations.Organizations, policyType, rootID string) error {
+	log.Printf("[DEBUG] Enabling Policy Type (%s) in Organization root: %s", policyType, rootID)
+	_, err := conn.EnablePolicyType(&organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     aws.String(rootID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error enabling policy type (%s) in Organization root (%s): %s", policyType, rootID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizations.PolicyTypeStatusPendingEnable},
+		Target:  []string{organizations.PolicyTypeStatusEnabled},
+		Refresh: organizationsPolicyTypeRefreshFunc(conn, rootID, policyType),
+		Timeout: 5 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for policy type (%s) to enable in Organization root (%s): %s", policyType, rootID, err)
+	}
+
+	return nil
+}
+
+func disableOrganizationsPolicyType(conn *organizations.Organizations, policyType, rootID string) error {
+	log.Printf("[DEBUG] Disabling Policy Type (%s) in Organization root: %s", policyType, rootID)
+	_, err := conn.DisablePolicyType(&organizations.DisablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     aws.String(rootID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error disabling policy type (%s) in Organization root (%s): %s", policyType, rootID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizations.PolicyTypeStatusPendingDisable},
+		Target:  []string{"DISABLED"},
+		Refresh: organizationsPolicyTypeRefreshFunc(conn, rootID, policyType),
+		Timeout: 5 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for policy type (%s) to disable in Organization root (%s): %s", policyType, rootID, err)
+	}
+
+	return nil
+}
+
+// organizationsPolicyTypeRefreshFunc reports the status of a policy type in
+// a root, or DISABLED once it is no longer listed.
+func organizationsPolicyTypeRefreshFunc(conn *organizations.Organizations, rootID, policyType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		roots, err := listOrganizationsRoots(conn)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, root := range roots {
+			if aws.StringValue(root.Id) != rootID {
+				continue
+			}
+
+			for _, summary := range root.PolicyTypes {
+				if aws.StringValue(summary.Type) == policyType {
+					return root, aws.StringValue(summary.Status), nil
+				}
+			}
+
+			return root, "DISABLED", nil
+		}
+
+		return nil, "", fmt.Errorf("Organization root (%s) not found", rootID)
+	}
+}
+
+func flattenOrganizationsRoots(roots []*organizations.Root) []map[string]interface{} {
+	if len(roots) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, r := range roots {
+		result = append(result, map[string]interface{}{
+			"id":           aws.StringValue(r.Id),
+			"name":         aws.StringValue(r.Name),
+			"arn":          aws.StringValue(r.Arn),
+			"policy_types": flattenOrganizationsRootPolicyTypeSummaries(r.PolicyTypes),
+		})
+	}
+	return result
+}
+
+func flattenOrganizationsRootPolicyTypeSummaries(summaries []*organizations.PolicyTypeSummary) []map[string]interface{} {
+	if len(summaries) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, s := range summaries {
+		result = append(result, map[string]interface{}{
+			"status": aws.StringValue(s.Status),
+			"type":   aws.StringValue(s.Type),
+		})
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsOrganizationalUnitCreate,
+		Read:   resourceAwsOrganizationsOrganizationalUnitRead,
+		Update: resourceAwsOrganizationsOrganizationalUnitUpdate,
+		Delete: resourceAwsOrganizationsOrganizationalUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_id": {
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"), "see https://docs.aws.amazon.com/organizations/latest/APIReference/API_CreateOrganizationalUnit.html#organizations-CreateOrganizationalUnit-request-ParentId"),
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsOrganizationalUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(d.Get("name").(string)),
+		ParentId: aws.String(d.Get("parent_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Organizational Unit: %s", input)
+	resp, err := conn.CreateOrganizationalUnit(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Organizations Organizational Unit: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.OrganizationalUnit.Id))
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	resp, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	ou := resp.OrganizationalUnit
+	if ou == nil {
+		log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parentID, err := resourceAwsOrganizationsAccountGetParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting Organizations Organizational Unit (%s) parent: %s", d.Id(), err)
+	}
+
+	d.Set("arn", ou.Arn)
+	d.Set("name", ou.Name)
+	d.Set("parent_id", parentID)
+
+	return nil
+}
+
+func resourceAwsOrganizationsOrganizationalUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("name") {
+		input := &organizations.UpdateOrganizationalUnitInput{
+			Name:                 aws.String(d.Get("name").(string)),
+			OrganizationalUnitId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Organizations Organizational Unit: %s", input)
+		if _, err := conn.UpdateOrganizationalUnit(input); err != nil {
+			return fmt.Errorf("Error updating Organizations Organizational Unit (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[DEBUG] Deleting Organizations Organizational Unit: %s", d.Id())
+	_, err := conn.DeleteOrganizationalUnit(&organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -7,9 +7,28 @@ import (
 func TestAccAWSOrganizations(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Organization": {
-			"basic":               testAccAwsOrganizationsOrganization_basic,
-			"importBasic":         testAccAwsOrganizationsOrganization_importBasic,
-			"consolidatedBilling": testAccAwsOrganizationsOrganization_consolidatedBilling,
+			"basic":                      testAccAwsOrganizationsOrganization_basic,
+			"importBasic":                testAccAwsOrganizationsOrganization_importBasic,
+			"consolidatedBilling":        testAccAwsOrganizationsOrganization_consolidatedBilling,
+			"AwsServiceAccessPrincipals": testAccAwsOrganizationsOrganization_AwsServiceAccessPrincipals,
+			"EnabledPolicyTypes":         testAccAwsOrganizationsOrganization_EnabledPolicyTypes,
+		},
+		"Account": {
+			"basic":    testAccAwsOrganizationsAccount_basic,
+			"ParentId": testAccAwsOrganizationsAccount_ParentId,
+		},
+		"OrganizationalUnit": {
+			"basic": testAccAwsOrganizationsOrganizationalUnit_basic,
+			"Name":  testAccAwsOrganizationsOrganizationalUnit_Name,
+		},
+		"Policy": {
+			"basic":       testAccAwsOrganizationsPolicy_basic,
+			"description": testAccAwsOrganizationsPolicy_description,
+		},
+		"PolicyAttachment": {
+			"Account":            testAccAwsOrganizationsPolicyAttachment_Account,
+			"OrganizationalUnit": testAccAwsOrganizationsPolicyAttachment_OrganizationalUnit,
+			"Root":               testAccAwsOrganizationsPolicyAttachment_Root,
 		},
 	}
 
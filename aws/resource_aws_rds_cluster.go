@@ -168,6 +168,48 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"s3_import": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"snapshot_identifier",
+					"replication_source_identifier",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ingestion_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"source_engine": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"mysql"}, false),
+						},
+						"source_engine_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"port": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -270,7 +312,7 @@ func resourceAwsRdsClusterImport(
 
 func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := TagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapRDS(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	var identifier string
 	if v, ok := d.GetOk("cluster_identifier"); ok {
@@ -426,6 +468,98 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[DEBUG]: RDS Cluster create response: %s", resp)
 
+	} else if v, ok := d.GetOk("s3_import"); ok {
+		if _, ok := d.GetOk("master_password"); !ok {
+			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: "master_password": required field is not set`, d.Get("database_name").(string))
+		}
+
+		if _, ok := d.GetOk("master_username"); !ok {
+			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: "master_username": required field is not set`, d.Get("database_name").(string))
+		}
+
+		s3Import := v.([]interface{})[0].(map[string]interface{})
+		createOpts := &rds.RestoreDBClusterFromS3Input{
+			DBClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
+			Engine:              aws.String(d.Get("engine").(string)),
+			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
+			MasterUsername:      aws.String(d.Get("master_username").(string)),
+			S3BucketName:        aws.String(s3Import["bucket_name"].(string)),
+			S3IngestionRoleArn:  aws.String(s3Import["ingestion_role"].(string)),
+			SourceEngine:        aws.String(s3Import["source_engine"].(string)),
+			SourceEngineVersion: aws.String(s3Import["source_engine_version"].(string)),
+			StorageEncrypted:    aws.Bool(d.Get("storage_encrypted").(bool)),
+			Tags:                tags,
+		}
+
+		if v := s3Import["bucket_prefix"].(string); v != "" {
+			createOpts.S3Prefix = aws.String(v)
+		}
+
+		if v := d.Get("database_name"); v.(string) != "" {
+			createOpts.DatabaseName = aws.String(v.(string))
+		}
+
+		if attr, ok := d.GetOk("port"); ok {
+			createOpts.Port = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			createOpts.DBSubnetGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("db_cluster_parameter_group_name"); ok {
+			createOpts.DBClusterParameterGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("engine_version"); ok {
+			createOpts.EngineVersion = aws.String(attr.(string))
+		}
+
+		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+			createOpts.VpcSecurityGroupIds = expandStringList(attr.List())
+		}
+
+		if attr := d.Get("availability_zones").(*schema.Set); attr.Len() > 0 {
+			createOpts.AvailabilityZones = expandStringList(attr.List())
+		}
+
+		if v, ok := d.GetOk("backup_retention_period"); ok {
+			createOpts.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("preferred_backup_window"); ok {
+			createOpts.PreferredBackupWindow = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+			createOpts.PreferredMaintenanceWindow = aws.String(v.(string))
+		}
+
+		if attr, ok := d.GetOk("kms_key_id"); ok {
+			createOpts.KmsKeyId = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			createOpts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+		}
+
+		log.Printf("[DEBUG] RDS Cluster restore from S3 configuration: %s", createOpts)
+		// A freshly created ingestion role may not have propagated yet, in
+		// which case RDS reports that it cannot read from the bucket.
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.RestoreDBClusterFromS3(createOpts)
+			if err != nil {
+				if isAWSErr(err, "InvalidParameterValue", "Files from the specified Amazon S3 bucket cannot be downloaded") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error restoring RDS Cluster from S3: %s", err)
+		}
+
 	} else {
 		if _, ok := d.GetOk("master_password"); !ok {
 			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: "master_password": required field is not set`, d.Get("database_name").(string))
@@ -705,6 +839,41 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// Clearing the replication source promotes the replica to a standalone,
+	// writable cluster.
+	if d.HasChange("replication_source_identifier") && d.Get("replication_source_identifier").(string) == "" {
+		log.Printf("[DEBUG] Promoting RDS Cluster (%s) read replica", d.Id())
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.PromoteReadReplicaDBCluster(&rds.PromoteReadReplicaDBClusterInput{
+				DBClusterIdentifier: aws.String(d.Id()),
+			})
+			if err != nil {
+				if isAWSErr(err, rds.ErrCodeInvalidDBClusterStateFault, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error promoting RDS Cluster (%s) read replica: %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be promoted", d.Id())
+		stateConf := &resource.StateChangeConf{
+			Pending:    resourceAwsRdsClusterPromotePendingStates,
+			Target:     []string{"available"},
+			Refresh:    resourceAwsRDSClusterPromoteRefreshFunc(d, meta),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for RDS Cluster (%s) to be promoted: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("iam_roles") {
 		oraw, nraw := d.GetChange("iam_roles")
 		if oraw == nil {
@@ -735,7 +904,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if arn, err := buildRDSClusterARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := SetTagsRDS(conn, d, arn); err != nil {
+		if err := SetTagsRDS(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -840,6 +1009,26 @@ func resourceAwsRDSClusterStateRefreshFunc(
 	}
 }
 
+// resourceAwsRDSClusterPromoteRefreshFunc reports a cluster that is still
+// attached to its replication source as "promoting", since the cluster can
+// be "available" again before it is detached and writable.
+func resourceAwsRDSClusterPromoteRefreshFunc(
+	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	refresh := resourceAwsRDSClusterStateRefreshFunc(d, meta)
+	return func() (interface{}, string, error) {
+		v, state, err := refresh()
+		if err != nil || state != "available" {
+			return v, state, err
+		}
+
+		if aws.StringValue(v.(*rds.DBCluster).ReplicationSourceIdentifier) != "" {
+			return v, "promoting", nil
+		}
+
+		return v, state, nil
+	}
+}
+
 func buildRDSClusterARN(identifier, partition, accountid, region string) (string, error) {
 	if partition == "" {
 		return "", fmt.Errorf("Unable to construct RDS Cluster ARN because of missing AWS partition")
@@ -888,6 +1077,12 @@ var resourceAwsRdsClusterCreatePendingStates = []string{
 	"resetting-master-credentials",
 }
 
+var resourceAwsRdsClusterPromotePendingStates = []string{
+	"promoting",
+	"modifying",
+	"backing-up",
+}
+
 var resourceAwsRdsClusterDeletePendingStates = []string{
 	"available",
 	"deleting",
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -257,6 +258,48 @@ func TestAccAWSRDSCluster_EncryptedCrossRegionReplication(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSCluster_CrossRegionReplicaPromotion(t *testing.T) {
+	var primaryCluster rds.DBCluster
+	var replicaCluster rds.DBCluster
+
+	// record the initialized providers so that we can use them to
+	// check for the cluster in each region
+	var providers []*schema.Provider
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSClusterDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSClusterConfigEncryptedCrossRegionReplica(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExistsWithProvider("aws_rds_cluster.test_primary",
+						&primaryCluster, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					testAccCheckAWSClusterExistsWithProvider("aws_rds_cluster.test_replica",
+						&replicaCluster, testAccAwsRegionProviderFunc("us-east-1", &providers)),
+					resource.TestCheckResourceAttrSet(
+						"aws_rds_cluster.test_replica", "replication_source_identifier"),
+				),
+			},
+			{
+				Config: testAccAWSClusterConfigEncryptedCrossRegionReplicaPromoted(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExistsWithProvider("aws_rds_cluster.test_replica",
+						&replicaCluster, testAccAwsRegionProviderFunc("us-east-1", &providers)),
+					func(s *terraform.State) error {
+						if v := aws.StringValue(replicaCluster.ReplicationSourceIdentifier); v != "" {
+							return fmt.Errorf("expected promoted cluster to have no replication source, got %s", v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSCluster_backupsUpdate(t *testing.T) {
 	var v rds.DBCluster
 
@@ -315,6 +358,43 @@ func TestAccAWSRDSCluster_iamAuth(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSCluster_s3Restore(t *testing.T) {
+	// The bucket must hold a Percona XtraBackup of a MySQL 5.6 database
+	// under the given prefix.
+	bucket := os.Getenv("RDS_CLUSTER_S3_IMPORT_BUCKET")
+	if bucket == "" {
+		t.Skip("Environment variable RDS_CLUSTER_S3_IMPORT_BUCKET is not set")
+	}
+	prefix := os.Getenv("RDS_CLUSTER_S3_IMPORT_PREFIX")
+
+	var v rds.DBCluster
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSClusterConfig_s3Restore(rInt, bucket, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExists("aws_rds_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_rds_cluster.default", "engine", "aurora"),
+					resource.TestCheckResourceAttr(
+						"aws_rds_cluster.default", "s3_import.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_rds_cluster.default", "s3_import.0.bucket_name", bucket),
+					resource.TestCheckResourceAttrSet(
+						"aws_rds_cluster.default", "endpoint"),
+					resource.TestCheckResourceAttrSet(
+						"aws_rds_cluster.default", "engine_version"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSClusterDestroy(s *terraform.State) error {
 	return testAccCheckAWSClusterDestroyWithProvider(s, testAccProvider)
 }
@@ -682,6 +762,74 @@ resource "aws_rds_cluster" "default" {
 }`, n)
 }
 
+func testAccAWSClusterConfig_s3Restore(n int, bucket, prefix string) string {
+	return fmt.Sprintf(`
+data "aws_s3_bucket" "xtrabackup" {
+  bucket = %[2]q
+}
+
+resource "aws_iam_role" "rds_s3_access_role" {
+  name = "tf-aurora-s3-import-%[1]d"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "rds.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "rds_s3_access" {
+  name = "tf-aurora-s3-import-%[1]d"
+  role = "${aws_iam_role.rds_s3_access_role.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListBucket",
+        "s3:GetObject"
+      ],
+      "Resource": [
+        "${data.aws_s3_bucket.xtrabackup.arn}",
+        "${data.aws_s3_bucket.xtrabackup.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_rds_cluster" "default" {
+  cluster_identifier = "tf-aurora-cluster-%[1]d"
+  availability_zones = ["us-west-2a","us-west-2b","us-west-2c"]
+  master_username = "root"
+  master_password = "mustbeeightcharaters"
+  skip_final_snapshot = true
+
+  s3_import {
+    bucket_name = "${data.aws_s3_bucket.xtrabackup.id}"
+    bucket_prefix = %[3]q
+    ingestion_role = "${aws_iam_role.rds_s3_access_role.arn}"
+    source_engine = "mysql"
+    source_engine_version = "5.6"
+  }
+
+  depends_on = ["aws_iam_role_policy.rds_s3_access"]
+}`, n, bucket, prefix)
+}
+
 func testAccAWSClusterConfigIncludingIamRoles(n int) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "rds_sample_role" {
@@ -904,6 +1052,47 @@ resource "aws_rds_cluster" "default" {
 }
 
 func testAccAWSClusterConfigEncryptedCrossRegionReplica(n int) string {
+	return testAccAWSClusterConfigEncryptedCrossRegionReplicaBase(n) + fmt.Sprintf(`
+resource "aws_rds_cluster" "test_replica" {
+  provider = "aws.useast1"
+  cluster_identifier = "tf-test-replica-%[1]d"
+  db_subnet_group_name = "${aws_db_subnet_group.replica.name}"
+  database_name = "mydb"
+  master_username = "foo"
+  master_password = "mustbeeightcharaters"
+  kms_key_id = "${aws_kms_key.kms_key_east.arn}"
+  storage_encrypted = true
+  skip_final_snapshot = true
+  replication_source_identifier = "arn:aws:rds:us-west-2:${data.aws_caller_identity.current.account_id}:cluster:${aws_rds_cluster.test_primary.cluster_identifier}"
+  source_region = "us-west-2"
+  depends_on = [
+  	"aws_rds_cluster_instance.test_instance"
+  ]
+}
+`, n)
+}
+
+func testAccAWSClusterConfigEncryptedCrossRegionReplicaPromoted(n int) string {
+	return testAccAWSClusterConfigEncryptedCrossRegionReplicaBase(n) + fmt.Sprintf(`
+resource "aws_rds_cluster" "test_replica" {
+  provider = "aws.useast1"
+  cluster_identifier = "tf-test-replica-%[1]d"
+  db_subnet_group_name = "${aws_db_subnet_group.replica.name}"
+  database_name = "mydb"
+  master_username = "foo"
+  master_password = "mustbeeightcharaters"
+  kms_key_id = "${aws_kms_key.kms_key_east.arn}"
+  storage_encrypted = true
+  skip_final_snapshot = true
+  source_region = "us-west-2"
+  depends_on = [
+  	"aws_rds_cluster_instance.test_instance"
+  ]
+}
+`, n)
+}
+
+func testAccAWSClusterConfigEncryptedCrossRegionReplicaBase(n int) string {
 	return fmt.Sprintf(`
 provider "aws" {
   alias  = "useast1"
@@ -1004,21 +1193,5 @@ resource "aws_db_subnet_group" "replica" {
   subnet_ids = ["${aws_subnet.db.*.id}"]
 }
 
-resource "aws_rds_cluster" "test_replica" {
-  provider = "aws.useast1"
-  cluster_identifier = "tf-test-replica-%[1]d"
-  db_subnet_group_name = "${aws_db_subnet_group.replica.name}"
-  database_name = "mydb"
-  master_username = "foo"
-  master_password = "mustbeeightcharaters"
-  kms_key_id = "${aws_kms_key.kms_key_east.arn}"
-  storage_encrypted = true
-  skip_final_snapshot = true
-  replication_source_identifier = "arn:aws:rds:us-west-2:${data.aws_caller_identity.current.account_id}:cluster:${aws_rds_cluster.test_primary.cluster_identifier}"
-  source_region = "us-west-2"
-  depends_on = [
-  	"aws_rds_cluster_instance.test_instance"
-  ]
-}
 `, n)
 }
@@ -54,7 +54,7 @@ func resourceAwsRedshiftSubnetGroupCreate(d *schema.ResourceData, meta interface
 	for i, subnetId := range subnetIdsSet.List() {
 		subnetIds[i] = aws.String(subnetId.(string))
 	}
-	tags := TagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := TagsFromMapRedshift(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})))
 
 	createOpts := redshift.CreateClusterSubnetGroupInput{
 		ClusterSubnetGroupName: aws.String(d.Get("name").(string)),
@@ -112,7 +112,7 @@ func resourceAwsRedshiftSubnetGroupUpdate(d *schema.ResourceData, meta interface
 	if tagErr != nil {
 		return fmt.Errorf("Error building ARN for Redshift Subnet Group, not updating Tags for Subnet Group %s", d.Id())
 	} else {
-		if tagErr := SetTagsRedshift(conn, d, arn); tagErr != nil {
+		if tagErr := SetTagsRedshift(conn, d, arn, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); tagErr != nil {
 			return tagErr
 		}
 	}
@@ -192,7 +192,7 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	if err := SetTagsR53(conn, d, "healthcheck"); err != nil {
+	if err := SetTagsR53(conn, d, "healthcheck", meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -298,7 +298,7 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(*resp.HealthCheck.Id)
 
-	if err := SetTagsR53(conn, d, "healthcheck"); err != nil {
+	if err := SetTagsR53(conn, d, "healthcheck", meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -251,7 +251,7 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if err := SetTagsR53(conn, d, "hostedzone"); err != nil {
+	if err := SetTagsR53(conn, d, "hostedzone", meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
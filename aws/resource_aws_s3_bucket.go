@@ -553,7 +553,7 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
-	if err := SetTagsS3(s3conn, d); err != nil {
+	if err := SetTagsS3(s3conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
@@ -923,6 +923,10 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 					if filter.Prefix != nil && *filter.Prefix != "" {
 						rule["prefix"] = *filter.Prefix
 					}
+					// Tag
+					if filter.Tag != nil {
+						rule["tags"] = TagsToMapS3([]*s3.Tag{filter.Tag})
+					}
 				}
 			} else {
 				if lifecycleRule.Prefix != nil {
@@ -1808,15 +1812,21 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		rule := &s3.LifecycleRule{}
 
 		// Filter
+		prefix := r["prefix"].(string)
 		tags := r["tags"].(map[string]interface{})
 		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) > 0 {
+		if prefix == "" && len(tags) == 1 {
+			// A single tag without a prefix cannot be expressed with an And operator
+			filter.SetTag(TagsFromMapS3(tags)[0])
+		} else if len(tags) > 0 {
 			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
+			if prefix != "" {
+				lifecycleRuleAndOp.SetPrefix(prefix)
+			}
 			lifecycleRuleAndOp.SetTags(TagsFromMapS3(tags))
 			filter.SetAnd(lifecycleRuleAndOp)
 		} else {
-			filter.SetPrefix(r["prefix"].(string))
+			filter.SetPrefix(prefix)
 		}
 		rule.SetFilter(filter)
 
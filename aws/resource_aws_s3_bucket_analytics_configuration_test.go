@@ -0,0 +1,465 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := map[string]struct {
+		Input    map[string]interface{}
+		Expected *s3.AnalyticsFilter
+	}{
+		"empty map returns nil": {
+			Input:    map[string]interface{}{},
+			Expected: nil,
+		},
+		"prefix only": {
+			Input: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			Expected: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		"prefix and single tag": {
+			Input: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+		"multiple tags": {
+			Input: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+		},
+		"single tag": {
+			Input: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Input)
+
+		if value == nil {
+			if tc.Expected == nil {
+				continue
+			}
+			t.Errorf("Case %q: Got nil\nExpected:\n%v", k, tc.Expected)
+			continue
+		}
+
+		if tc.Expected == nil {
+			t.Errorf("Case %q: Got: %v\nExpected: nil", k, value)
+			continue
+		}
+
+		// Sort tags by key for consistency
+		if value.And != nil && value.And.Tags != nil {
+			sort.Slice(value.And.Tags, func(i, j int) bool {
+				return *value.And.Tags[i].Key < *value.And.Tags[j].Key
+			})
+		}
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case %q:\nGot:\n%v\nExpected:\n%v", k, value, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenS3AnalyticsFilter(t *testing.T) {
+	testCases := map[string]struct {
+		Input    *s3.AnalyticsFilter
+		Expected []map[string]interface{}
+	}{
+		"nil input": {
+			Input:    nil,
+			Expected: nil,
+		},
+		"empty input": {
+			Input:    &s3.AnalyticsFilter{},
+			Expected: nil,
+		},
+		"prefix only": {
+			Input: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+			Expected: []map[string]interface{}{
+				{
+					"prefix": "prefix/",
+				},
+			},
+		},
+		"prefix and tags": {
+			Input: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"prefix": "prefix/",
+					"tags": map[string]string{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+		},
+		"single tag": {
+			Input: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"tags": map[string]string{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := flattenS3AnalyticsFilter(tc.Input)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case %q: Got:\n%v\n\nExpected:\n%v", k, value, tc.Expected)
+		}
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithFilter(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	prefix := fmt.Sprintf("prefix-%d/", rInt)
+	prefixUpdate := fmt.Sprintf("prefix-update-%d/", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(rName, rName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(rName, rName, prefixUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefixUpdate),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(rName, rName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, rName, "analytics/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, ac *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.AnalyticsConfiguration == nil {
+			return fmt.Errorf("S3 bucket analytics configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*ac = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := &s3.GetBucketAnalyticsConfigurationInput{
+				Bucket: aws.String(bucket),
+				Id:     aws.String(name),
+			}
+			log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+			output, err := conn.GetBucketAnalyticsConfiguration(input)
+			if err != nil {
+				if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+					return nil
+				}
+				return resource.NonRetryableError(err)
+			}
+			if output.AnalyticsConfiguration != nil {
+				return resource.RetryableError(fmt.Errorf("S3 bucket analytics configuration exists: %v", output))
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "%s"
+}
+`, bucketName)
+}
+
+func testAccAWSS3BucketAnalyticsConfiguration(name, bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), name)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(name, bucketName, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), name, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(name, bucketName, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+
+    tags {
+      "tag1" = "value1"
+      "tag2" = "value2"
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), name, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(name, bucketName, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn = "${aws_s3_bucket.destination.arn}"
+          prefix     = "%s"
+        }
+      }
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), bucketName, name, prefix)
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"tags": TagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &sagemaker.CreateEndpointInput{
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		EndpointName:       aws.String(name),
+	}
+
+	if tags := TagsFromMapSagemaker(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Endpoint: %s", input)
+	if _, err := conn.CreateEndpoint(input); err != nil {
+		return fmt.Errorf("Error creating SageMaker Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerEndpointInService(conn, d.Id(), sagemaker.EndpointStatusCreating, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker Endpoint (%s) to be in service: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	resp, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			log.Printf("[WARN] SageMaker Endpoint (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.EndpointArn)
+	d.Set("endpoint_config_name", resp.EndpointConfigName)
+	d.Set("name", resp.EndpointName)
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(resp.EndpointArn))
+	if err != nil {
+		return fmt.Errorf("Error reading SageMaker Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", TagsToMapSagemaker(tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := SetTagsSagemaker(conn, d, d.Get("arn").(string), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error updating SageMaker Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.HasChange("endpoint_config_name") {
+		input := &sagemaker.UpdateEndpointInput{
+			EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+			EndpointName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Endpoint: %s", input)
+		if _, err := conn.UpdateEndpoint(input); err != nil {
+			return fmt.Errorf("Error updating SageMaker Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerEndpointInService(conn, d.Id(), sagemaker.EndpointStatusUpdating, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for SageMaker Endpoint (%s) to be in service: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.EndpointStatusDeleting},
+		Target:  []string{""},
+		Refresh: sagemakerEndpointStatusRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker Endpoint (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForSagemakerEndpointInService(conn *sagemaker.SageMaker, name, pending string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pending},
+		Target:  []string{sagemaker.EndpointStatusInService},
+		Refresh: sagemakerEndpointStatusRefreshFunc(conn, name),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func sagemakerEndpointStatusRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+				return name, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.EndpointStatus)
+		if status == sagemaker.EndpointStatusFailed {
+			return resp, status, fmt.Errorf("%s", aws.StringValue(resp.FailureReason))
+		}
+
+		return resp, status, nil
+	}
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointConfigurationCreate,
+		Read:   resourceAwsSagemakerEndpointConfigurationRead,
+		Update: resourceAwsSagemakerEndpointConfigurationUpdate,
+		Delete: resourceAwsSagemakerEndpointConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"production_variants": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"initial_variant_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							ForceNew: true,
+							Default:  1,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"variant_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"tags": TagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		ProductionVariants: expandSagemakerProductionVariants(d.Get("production_variants").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if tags := TagsFromMapSagemaker(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Endpoint Configuration: %s", input)
+	if _, err := conn.CreateEndpointConfig(input); err != nil {
+		return fmt.Errorf("Error creating SageMaker Endpoint Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	resp, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			log.Printf("[WARN] SageMaker Endpoint Configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.EndpointConfigArn)
+	d.Set("kms_key_arn", resp.KmsKeyId)
+	d.Set("name", resp.EndpointConfigName)
+
+	if err := d.Set("production_variants", flattenSagemakerProductionVariants(resp.ProductionVariants)); err != nil {
+		return fmt.Errorf("Error setting production_variants: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(resp.EndpointConfigArn))
+	if err != nil {
+		return fmt.Errorf("Error reading SageMaker Endpoint Configuration (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", TagsToMapSagemaker(tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := SetTagsSagemaker(conn, d, d.Get("arn").(string), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error updating SageMaker Endpoint Configuration (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Endpoint Configuration: %s", d.Id())
+	_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerProductionVariants(l []interface{}) []*sagemaker.ProductionVariant {
+	variants := make([]*sagemaker.ProductionVariant, 0, len(l))
+
+	for i, raw := range l {
+		m := raw.(map[string]interface{})
+
+		variant := &sagemaker.ProductionVariant{
+			InitialInstanceCount: aws.Int64(int64(m["initial_instance_count"].(int))),
+			InitialVariantWeight: aws.Float64(m["initial_variant_weight"].(float64)),
+			InstanceType:         aws.String(m["instance_type"].(string)),
+			ModelName:            aws.String(m["model_name"].(string)),
+		}
+
+		if v, ok := m["variant_name"].(string); ok && v != "" {
+			variant.VariantName = aws.String(v)
+		} else {
+			variant.VariantName = aws.String(fmt.Sprintf("variant-%d", i+1))
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants
+}
+
+func flattenSagemakerProductionVariants(variants []*sagemaker.ProductionVariant) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(variants))
+
+	for _, variant := range variants {
+		result = append(result, map[string]interface{}{
+			"initial_instance_count": int(aws.Int64Value(variant.InitialInstanceCount)),
+			"initial_variant_weight": aws.Float64Value(variant.InitialVariantWeight),
+			"instance_type":          aws.StringValue(variant.InstanceType),
+			"model_name":             aws.StringValue(variant.ModelName),
+			"variant_name":           aws.StringValue(variant.VariantName),
+		})
+	}
+
+	return result
+}
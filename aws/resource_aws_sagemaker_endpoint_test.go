@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName, "${aws_sagemaker_endpoint_configuration.test.name}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_config_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName, "${aws_sagemaker_endpoint_configuration.updated.name}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_config_name", rName+"-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerEndpointExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		resp, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.EndpointStatus); status != sagemaker.EndpointStatusInService {
+			return fmt.Errorf("SageMaker Endpoint %q is %s, expected %s", rs.Primary.ID, status, sagemaker.EndpointStatusInService)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("SageMaker Endpoint %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerEndpointConfig(rName, endpointConfigName string) string {
+	return testAccAWSSagemakerEndpointConfigurationConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "updated" {
+  name = "%[1]s-updated"
+
+  production_variants {
+    initial_instance_count = 1
+    instance_type          = "ml.m4.xlarge"
+    model_name             = "${aws_sagemaker_model.test.name}"
+  }
+}
+
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = %[2]q
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, endpointConfigName)
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerModelCreate,
+		Read:   resourceAwsSagemakerModelRead,
+		Update: resourceAwsSagemakerModelUpdate,
+		Delete: resourceAwsSagemakerModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"primary_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_hostname": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"model_data_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"tags": TagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &sagemaker.CreateModelInput{
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		ModelName:        aws.String(name),
+		PrimaryContainer: expandSagemakerContainerDefinition(d.Get("primary_container").([]interface{})),
+	}
+
+	if tags := TagsFromMapSagemaker(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Model: %s", input)
+	if _, err := conn.CreateModel(input); err != nil {
+		return fmt.Errorf("Error creating SageMaker Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	resp, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			log.Printf("[WARN] SageMaker Model (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.ModelArn)
+	d.Set("execution_role_arn", resp.ExecutionRoleArn)
+	d.Set("name", resp.ModelName)
+
+	if err := d.Set("primary_container", flattenSagemakerContainerDefinition(resp.PrimaryContainer)); err != nil {
+		return fmt.Errorf("Error setting primary_container: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(resp.ModelArn))
+	if err != nil {
+		return fmt.Errorf("Error reading SageMaker Model (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", TagsToMapSagemaker(tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := SetTagsSagemaker(conn, d, d.Get("arn").(string), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error updating SageMaker Model (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Model: %s", d.Id())
+	_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker Model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerContainerDefinition(l []interface{}) *sagemaker.ContainerDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	container := &sagemaker.ContainerDefinition{
+		Image: aws.String(m["image"].(string)),
+	}
+
+	if v, ok := m["container_hostname"].(string); ok && v != "" {
+		container.ContainerHostname = aws.String(v)
+	}
+
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		container.Environment = stringMapToPointers(v)
+	}
+
+	if v, ok := m["model_data_url"].(string); ok && v != "" {
+		container.ModelDataUrl = aws.String(v)
+	}
+
+	return container
+}
+
+func flattenSagemakerContainerDefinition(container *sagemaker.ContainerDefinition) []map[string]interface{} {
+	if container == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_hostname": aws.StringValue(container.ContainerHostname),
+		"environment":        pointersMapToStringList(container.Environment),
+		"image":              aws.StringValue(container.Image),
+		"model_data_url":     aws.StringValue(container.ModelDataUrl),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerModel_basic(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.test", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerModelExists(n string, v *sagemaker.DescribeModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		resp, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_model" {
+			continue
+		}
+
+		_, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("SageMaker Model %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, "ValidationException", "Could not find model") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = "${data.aws_iam_policy_document.assume_role.json}"
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:aws:iam::aws:policy/AmazonSageMakerFullAccess"
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfig(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+
+    environment {
+      test = "bar"
+    }
+  }
+
+  tags {
+    Name = %[1]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName)
+}
@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerNotebookInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceRead,
+		Update: resourceAwsSagemakerNotebookInstanceUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direct_internet_access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sagemaker.DirectInternetAccessEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					sagemaker.DirectInternetAccessDisabled,
+					sagemaker.DirectInternetAccessEnabled,
+				}, false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"lifecycle_config_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": TagsSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	name := d.Get("name").(string)
+
+	input := &sagemaker.CreateNotebookInstanceInput{
+		DirectInternetAccess: aws.String(d.Get("direct_internet_access").(string)),
+		InstanceType:         aws.String(d.Get("instance_type").(string)),
+		NotebookInstanceName: aws.String(name),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lifecycle_config_name"); ok {
+		input.LifecycleConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_groups"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if tags := TagsFromMapSagemaker(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Notebook Instance: %s", input)
+	if _, err := conn.CreateNotebookInstance(input); err != nil {
+		return fmt.Errorf("Error creating SageMaker Notebook Instance (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), []string{sagemaker.NotebookInstanceStatusPending}, sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker Notebook Instance (%s) to be in service: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			log.Printf("[WARN] SageMaker Notebook Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.NotebookInstanceArn)
+	d.Set("direct_internet_access", resp.DirectInternetAccess)
+	d.Set("instance_type", resp.InstanceType)
+	d.Set("kms_key_id", resp.KmsKeyId)
+	d.Set("lifecycle_config_name", resp.NotebookInstanceLifecycleConfigName)
+	d.Set("name", resp.NotebookInstanceName)
+	d.Set("network_interface_id", resp.NetworkInterfaceId)
+	d.Set("role_arn", resp.RoleArn)
+	d.Set("subnet_id", resp.SubnetId)
+	d.Set("url", resp.Url)
+
+	if err := d.Set("security_groups", flattenStringList(resp.SecurityGroups)); err != nil {
+		return fmt.Errorf("Error setting security_groups: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(resp.NotebookInstanceArn))
+	if err != nil {
+		return fmt.Errorf("Error reading SageMaker Notebook Instance (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", TagsToMapSagemaker(tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if err := SetTagsSagemaker(conn, d, d.Get("arn").(string), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error updating SageMaker Notebook Instance (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	if d.HasChange("instance_type") || d.HasChange("role_arn") {
+		// Notebook instances can only be updated while stopped
+		wasRunning, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		input := &sagemaker.UpdateNotebookInstanceInput{
+			InstanceType:         aws.String(d.Get("instance_type").(string)),
+			NotebookInstanceName: aws.String(d.Id()),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Notebook Instance: %s", input)
+		if _, err := conn.UpdateNotebookInstance(input); err != nil {
+			return fmt.Errorf("Error updating SageMaker Notebook Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), []string{"Updating"}, sagemaker.NotebookInstanceStatusStopped, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for SageMaker Notebook Instance (%s) update: %s", d.Id(), err)
+		}
+
+		d.SetPartial("instance_type")
+		d.SetPartial("role_arn")
+
+		if wasRunning {
+			log.Printf("[DEBUG] Starting SageMaker Notebook Instance: %s", d.Id())
+			_, err := conn.StartNotebookInstance(&sagemaker.StartNotebookInstanceInput{
+				NotebookInstanceName: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error starting SageMaker Notebook Instance (%s): %s", d.Id(), err)
+			}
+
+			if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), []string{sagemaker.NotebookInstanceStatusPending}, sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Error waiting for SageMaker Notebook Instance (%s) to be in service: %s", d.Id(), err)
+			}
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	// Notebook instances can only be deleted while stopped, a failed one
+	// cannot be stopped and is deleted right away
+	if aws.StringValue(resp.NotebookInstanceStatus) != sagemaker.NotebookInstanceStatusFailed {
+		if _, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if isAWSErr(err, "ValidationException", "RecordNotFound") {
+				return nil
+			}
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker Notebook Instance: %s", d.Id())
+	_, err = conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), []string{sagemaker.NotebookInstanceStatusDeleting}, "", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker Notebook Instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// stopSagemakerNotebookInstance stops the notebook instance if it is running
+// and reports whether it was running.
+func stopSagemakerNotebookInstance(conn *sagemaker.SageMaker, name string, timeout time.Duration) (bool, error) {
+	// A pending notebook instance cannot be stopped yet
+	if err := waitForSagemakerNotebookInstanceStatus(conn, name, []string{sagemaker.NotebookInstanceStatusPending}, "", timeout); err != nil {
+		return false, err
+	}
+
+	resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if aws.StringValue(resp.NotebookInstanceStatus) != sagemaker.NotebookInstanceStatusInService {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Stopping SageMaker Notebook Instance: %s", name)
+	_, err = conn.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	})
+	if err != nil {
+		return true, fmt.Errorf("Error stopping SageMaker Notebook Instance (%s): %s", name, err)
+	}
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, name, []string{sagemaker.NotebookInstanceStatusStopping}, sagemaker.NotebookInstanceStatusStopped, timeout); err != nil {
+		return true, fmt.Errorf("Error waiting for SageMaker Notebook Instance (%s) to stop: %s", name, err)
+	}
+
+	return true, nil
+}
+
+// waitForSagemakerNotebookInstanceStatus waits while the notebook instance is
+// in one of the pending statuses. An empty target accepts any other status.
+func waitForSagemakerNotebookInstanceStatus(conn *sagemaker.SageMaker, name string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+				NotebookInstanceName: aws.String(name),
+			})
+			if err != nil {
+				if isAWSErr(err, "ValidationException", "RecordNotFound") {
+					return name, "", nil
+				}
+				return nil, "", err
+			}
+
+			status := aws.StringValue(resp.NotebookInstanceStatus)
+			if status == sagemaker.NotebookInstanceStatusFailed {
+				return resp, status, fmt.Errorf("%s", aws.StringValue(resp.FailureReason))
+			}
+
+			for _, p := range pending {
+				if status == p {
+					return resp, status, nil
+				}
+			}
+
+			if target == "" {
+				return resp, target, nil
+			}
+
+			return resp, status, nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerNotebookInstance_basic(t *testing.T) {
+	var notebook sagemaker.DescribeNotebookInstanceOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfig(rName, "ml.t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.medium"),
+					resource.TestCheckResourceAttr(resourceName, "direct_internet_access", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfig(rName, "ml.m4.xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.m4.xlarge"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceExists(n string, v *sagemaker.DescribeNotebookInstanceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Notebook Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.NotebookInstanceStatus); status != sagemaker.NotebookInstanceStatusInService {
+			return fmt.Errorf("SageMaker Notebook Instance %q is %s, expected %s", rs.Primary.ID, status, sagemaker.NotebookInstanceStatusInService)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_notebook_instance" {
+			continue
+		}
+
+		_, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("SageMaker Notebook Instance %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerNotebookInstanceConfig(rName, instanceType string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance" "test" {
+  name          = %[1]q
+  role_arn      = "${aws_iam_role.test.arn}"
+  instance_type = %[2]q
+
+  tags {
+    Name = %[1]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, instanceType)
+}
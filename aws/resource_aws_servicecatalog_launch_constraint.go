@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The constraint detail returned by Service Catalog does not include the
+// portfolio and product, so launch constraints cannot be imported.
+func resourceAwsServiceCatalogLaunchConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogLaunchConstraintCreate,
+		Read:   resourceAwsServiceCatalogLaunchConstraintRead,
+		Update: resourceAwsServiceCatalogLaunchConstraintUpdate,
+		Delete: resourceAwsServiceCatalogLaunchConstraintDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+type serviceCatalogLaunchConstraintParameters struct {
+	RoleArn string
+}
+
+func resourceAwsServiceCatalogLaunchConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	parameters, err := json.Marshal(serviceCatalogLaunchConstraintParameters{
+		RoleArn: d.Get("role_arn").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(string(parameters)),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String("LAUNCH"),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Launch Constraint: %#v", input)
+	resp, err := conn.CreateConstraint(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Launch Constraint failed: %s", err)
+	}
+	d.SetId(aws.StringValue(resp.ConstraintDetail.ConstraintId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+				AcceptLanguage: aws.String("en"),
+				Id:             aws.String(d.Id()),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.Status)
+			if status == servicecatalog.StatusFailed {
+				return resp, status, fmt.Errorf("Service Catalog Launch Constraint '%s' failed to create", d.Id())
+			}
+			return resp, status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Waiting for Service Catalog Launch Constraint '%s' failed: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogLaunchConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Launch Constraint: %#v", input)
+	resp, err := conn.DescribeConstraint(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Launch Constraint %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Launch Constraint '%s' failed: %s", d.Id(), err)
+	}
+
+	d.Set("description", resp.ConstraintDetail.Description)
+	d.Set("owner", resp.ConstraintDetail.Owner)
+
+	if v := aws.StringValue(resp.ConstraintParameters); v != "" {
+		var parameters serviceCatalogLaunchConstraintParameters
+		if err := json.Unmarshal([]byte(v), &parameters); err != nil {
+			return fmt.Errorf("Parsing Service Catalog Launch Constraint '%s' parameters failed: %s", d.Id(), err)
+		}
+		d.Set("role_arn", parameters.RoleArn)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogLaunchConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Description:    aws.String(d.Get("description").(string)),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Launch Constraint: %#v", input)
+	if _, err := conn.UpdateConstraint(&input); err != nil {
+		return fmt.Errorf("Updating Service Catalog Launch Constraint '%s' failed: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogLaunchConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Launch Constraint: %#v", input)
+	if _, err := conn.DeleteConstraint(&input); err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Launch Constraint '%s' failed: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogLaunchConstraint_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_launch_constraint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogLaunchConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogLaunchConstraintConfig(rName, "Initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogLaunchConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Initial"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			{
+				Config: testAccServiceCatalogLaunchConstraintConfig(rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogLaunchConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogLaunchConstraintExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Launch Constraint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckServiceCatalogLaunchConstraintDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_launch_constraint" {
+			continue
+		}
+
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Launch Constraint %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogLaunchConstraintConfig(rName, description string) string {
+	return testAccServiceCatalogProductPortfolioAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "servicecatalog.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_servicecatalog_launch_constraint" "test" {
+  description  = %[2]q
+  portfolio_id = "${aws_servicecatalog_product_portfolio_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_portfolio_association.test.product_id}"
+  role_arn     = "${aws_iam_role.test.arn}"
+}
+`, rName, description)
+}
@@ -76,9 +76,8 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if t := meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{})); len(t) > 0 {
 		tags := []*servicecatalog.Tag{}
-		t := v.(map[string]interface{})
 		for k, v := range t {
 			tag := servicecatalog.Tag{
 				Key:   aws.String(k),
@@ -164,7 +163,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
-		tagsToAdd, tagsToRemove := tagUpdates(requiredTags.(map[string]interface{}), currentTags.(map[string]interface{}))
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		tagsToAdd, tagsToRemove := tagUpdates(ignoreTagsConfig.RemoveIgnored(meta.(*AWSClient).defaultTagsConfig.MergeTags(requiredTags.(map[string]interface{}))), ignoreTagsConfig.RemoveIgnored(currentTags.(map[string]interface{})))
 		log.Printf("[DEBUG] Tags To Add: %#v", tagsToAdd)
 		log.Printf("[DEBUG] Tags To Remove: %#v", tagsToRemove)
 		input.AddTags = tagsToAdd
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID := d.Get("portfolio_id").(string)
+	accountID := d.Get("account_id").(string)
+	input := servicecatalog.CreatePortfolioShareInput{
+		AcceptLanguage: aws.String("en"),
+		AccountId:      aws.String(accountID),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %#v", input)
+	if _, err := conn.CreatePortfolioShare(&input); err != nil {
+		return fmt.Errorf("Sharing Service Catalog Portfolio '%s' with account '%s' failed: %s", portfolioID, accountID, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, accountID))
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, accountID, err := parseServiceCatalogPortfolioShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListPortfolioAccessInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Portfolio Share: %#v", input)
+	resp, err := conn.ListPortfolioAccess(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Portfolio Share %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Portfolio Share '%s' failed: %s", d.Id(), err)
+	}
+
+	found := false
+	for _, id := range resp.AccountIds {
+		if aws.StringValue(id) == accountID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Portfolio Share %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("portfolio_id", portfolioID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, accountID, err := parseServiceCatalogPortfolioShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DeletePortfolioShareInput{
+		AcceptLanguage: aws.String("en"),
+		AccountId:      aws.String(accountID),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Portfolio Share: %#v", input)
+	if _, err := conn.DeletePortfolioShare(&input); err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Portfolio Share '%s' failed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func parseServiceCatalogPortfolioShareID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected PORTFOLIO_ID:ACCOUNT_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
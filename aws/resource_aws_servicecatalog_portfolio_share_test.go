@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	accountID := os.Getenv("SERVICECATALOG_SHARE_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip("Environment variable SERVICECATALOG_SHARE_ACCOUNT_ID is not set")
+	}
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogPortfolioShareConfig(accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		portfolioID, accountID, err := parseServiceCatalogPortfolioShareID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListPortfolioAccess(&servicecatalog.ListPortfolioAccessInput{
+			PortfolioId: aws.String(portfolioID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, id := range resp.AccountIds {
+			if aws.StringValue(id) == accountID {
+				return fmt.Errorf("Service Catalog Portfolio Share %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogPortfolioShareConfig(accountID string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "tf-acc-test"
+  provider_name = "Terraform"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  account_id   = %q
+}
+`, accountID)
+}
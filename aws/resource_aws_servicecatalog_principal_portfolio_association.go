@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID := d.Get("portfolio_id").(string)
+	principalARN := d.Get("principal_arn").(string)
+	input := servicecatalog.AssociatePrincipalWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(servicecatalog.PrincipalTypeIam),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Principal with Portfolio: %#v", input)
+	if _, err := conn.AssociatePrincipalWithPortfolio(&input); err != nil {
+		return fmt.Errorf("Associating Service Catalog Principal '%s' with Portfolio '%s' failed: %s", principalARN, portfolioID, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, principalARN))
+
+	return resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, principalARN, err := parseServiceCatalogPrincipalPortfolioAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListPrincipalsForPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	found := false
+	err = conn.ListPrincipalsForPortfolioPages(&input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, principal := range page.Principals {
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			found = false
+		} else {
+			return fmt.Errorf("Reading Service Catalog Principal Portfolio Association '%s' failed: %s", d.Id(), err)
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_arn", principalARN)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, principalARN, err := parseServiceCatalogPrincipalPortfolioAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Principal from Portfolio: %#v", input)
+	if _, err := conn.DisassociatePrincipalFromPortfolio(&input); err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating Service Catalog Principal '%s' from Portfolio '%s' failed: %s", principalARN, portfolioID, err)
+	}
+	return nil
+}
+
+// Principal ARNs contain colons, so only the first one separates the parts.
+func parseServiceCatalogPrincipalPortfolioAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected PORTFOLIO_ID:PRINCIPAL_ARN", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseServiceCatalogPrincipalPortfolioAssociationID(t *testing.T) {
+	portfolioID, principalARN, err := parseServiceCatalogPrincipalPortfolioAssociationID("port-abc:arn:aws:iam::123456789012:role/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if portfolioID != "port-abc" || principalARN != "arn:aws:iam::123456789012:role/test" {
+		t.Fatalf("unexpected parts: %q, %q", portfolioID, principalARN)
+	}
+
+	if _, _, err := parseServiceCatalogPrincipalPortfolioAssociationID("port-abc"); err == nil {
+		t.Fatal("expected an error for an ID without a principal ARN")
+	}
+}
+
+func TestAccAWSServiceCatalogPrincipalPortfolioAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_principal_portfolio_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogPrincipalPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogPrincipalPortfolioAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogPrincipalPortfolioAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_principal_portfolio_association" {
+			continue
+		}
+
+		portfolioID, principalARN, err := parseServiceCatalogPrincipalPortfolioAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListPrincipalsForPortfolio(&servicecatalog.ListPrincipalsForPortfolioInput{
+			PortfolioId: aws.String(portfolioID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, principal := range resp.Principals {
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				return fmt.Errorf("Service Catalog Principal Portfolio Association %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogPrincipalPortfolioAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "servicecatalog.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "tf-acc-test"
+  provider_name = "Terraform"
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = "${aws_servicecatalog_portfolio.test.id}"
+  principal_arn = "${aws_iam_role.test.arn}"
+}
+`, rName)
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogProductPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogProductPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+	input := servicecatalog.AssociateProductWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Product with Portfolio: %#v", input)
+	if _, err := conn.AssociateProductWithPortfolio(&input); err != nil {
+		return fmt.Errorf("Associating Service Catalog Product '%s' with Portfolio '%s' failed: %s", productID, portfolioID, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, productID))
+
+	return resourceAwsServiceCatalogProductPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, productID, err := parseServiceCatalogProductPortfolioAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListPortfoliosForProductInput{
+		AcceptLanguage: aws.String("en"),
+		ProductId:      aws.String(productID),
+	}
+
+	found := false
+	err = conn.ListPortfoliosForProductPages(&input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			found = false
+		} else {
+			return fmt.Errorf("Reading Service Catalog Product Portfolio Association '%s' failed: %s", d.Id(), err)
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID, productID, err := parseServiceCatalogProductPortfolioAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DisassociateProductFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Product from Portfolio: %#v", input)
+	if _, err := conn.DisassociateProductFromPortfolio(&input); err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating Service Catalog Product '%s' from Portfolio '%s' failed: %s", productID, portfolioID, err)
+	}
+	return nil
+}
+
+func parseServiceCatalogProductPortfolioAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected PORTFOLIO_ID:PRODUCT_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
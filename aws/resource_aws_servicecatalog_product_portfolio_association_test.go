@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProductPortfolioAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product_portfolio_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogProductPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogProductPortfolioAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogProductPortfolioAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product_portfolio_association" {
+			continue
+		}
+
+		portfolioID, productID, err := parseServiceCatalogProductPortfolioAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListPortfoliosForProduct(&servicecatalog.ListPortfoliosForProductInput{
+			ProductId: aws.String(productID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, portfolio := range resp.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				return fmt.Errorf("Service Catalog Product Portfolio Association %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogProductPortfolioAssociationConfig(rName string) string {
+	return testAccServiceCatalogProductConfig(rName, "Owner") + `
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "tf-acc-test"
+  provider_name = "Terraform"
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  product_id   = "${aws_servicecatalog_product.test.id}"
+}
+`
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogProductConfig(rName, "Owner One"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "Owner One"),
+					resource.TestCheckResourceAttr(resourceName, "type", "CLOUD_FORMATION_TEMPLATE"),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "support@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+				),
+			},
+			{
+				Config: testAccServiceCatalogProductConfig(rName, "Owner Two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "Owner Two"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_artifact_parameters", "provisioning_artifact_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogProductExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Product %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogProductConfigTemplate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "template.json"
+  content = <<TEMPLATE
+{
+  "Resources": {
+    "Topic": {
+      "Type": "AWS::SNS::Topic"
+    }
+  }
+}
+TEMPLATE
+}
+`, rName)
+}
+
+func testAccServiceCatalogProductConfig(rName, owner string) string {
+	return testAccServiceCatalogProductConfigTemplate(rName) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name          = %[1]q
+  owner         = %[2]q
+  description   = "Terraform Acceptance Test"
+  support_email = "support@example.com"
+
+  provisioning_artifact_parameters {
+    name         = "v1"
+    template_url = "https://s3.amazonaws.com/${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, owner)
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseServiceCatalogProvisioningArtifactID(t *testing.T) {
+	productID, artifactID, err := parseServiceCatalogProvisioningArtifactID("prod-abc:pa-def")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if productID != "prod-abc" || artifactID != "pa-def" {
+		t.Fatalf("unexpected parts: %q, %q", productID, artifactID)
+	}
+
+	for _, id := range []string{"", "prod-abc", "prod-abc:", ":pa-def"} {
+		if _, _, err := parseServiceCatalogProvisioningArtifactID(id); err == nil {
+			t.Fatalf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccAWSServiceCatalogProvisioningArtifact_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogProvisioningArtifactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogProvisioningArtifactConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+				),
+			},
+			{
+				Config: testAccServiceCatalogProvisioningArtifactConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_url"},
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogProvisioningArtifactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		productID, artifactID, err := parseServiceCatalogProvisioningArtifactID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckServiceCatalogProvisioningArtifactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioning_artifact" {
+			continue
+		}
+
+		productID, artifactID, err := parseServiceCatalogProvisioningArtifactID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Provisioning Artifact %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogProvisioningArtifactConfig(rName string, active bool) string {
+	return testAccServiceCatalogProductConfig(rName, "Owner") + fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  product_id   = "${aws_servicecatalog_product.test.id}"
+  name         = "v2"
+  description  = "Second version"
+  template_url = "https://s3.amazonaws.com/${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.key}"
+  active       = %t
+}
+`, active)
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogTagOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionCreate,
+		Read:   resourceAwsServiceCatalogTagOptionRead,
+		Update: resourceAwsServiceCatalogTagOptionUpdate,
+		Delete: resourceAwsServiceCatalogTagOptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.CreateTagOptionInput{
+		Key:   aws.String(d.Get("key").(string)),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option: %#v", input)
+	resp, err := conn.CreateTagOption(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Tag Option failed: %s", err)
+	}
+	d.SetId(aws.StringValue(resp.TagOptionDetail.Id))
+
+	// Tag options are always created active
+	if !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogTagOptionUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Tag Option: %#v", input)
+	resp, err := conn.DescribeTagOption(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Tag Option %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Tag Option '%s' failed: %s", d.Id(), err)
+	}
+
+	d.Set("active", resp.TagOptionDetail.Active)
+	d.Set("key", resp.TagOptionDetail.Key)
+	d.Set("value", resp.TagOptionDetail.Value)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.UpdateTagOptionInput{
+		Active: aws.Bool(d.Get("active").(bool)),
+		Id:     aws.String(d.Id()),
+		Value:  aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Tag Option: %#v", input)
+	if _, err := conn.UpdateTagOption(&input); err != nil {
+		return fmt.Errorf("Updating Service Catalog Tag Option '%s' failed: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DeleteTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Tag Option: %#v", input)
+	if _, err := conn.DeleteTagOption(&input); err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Tag Option '%s' failed: %s", d.Id(), err)
+	}
+	return nil
+}
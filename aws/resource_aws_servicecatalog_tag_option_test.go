@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOption_basic(t *testing.T) {
+	key := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_tag_option.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceCatalogTagOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceCatalogTagOptionConfig(key, "one", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttr(resourceName, "value", "one"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				Config: testAccServiceCatalogTagOptionConfig(key, "two", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "two"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceCatalogTagOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Tag Option ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckServiceCatalogTagOptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option" {
+			continue
+		}
+
+		_, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Tag Option %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogTagOptionConfig(key, value string, active bool) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_tag_option" "test" {
+  key    = %q
+  value  = %q
+  active = %t
+}
+`, key, value, active)
+}
@@ -52,9 +52,10 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 			// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetLaunchSpecification
 			// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetLaunchSpecification.html
 			"launch_specification": {
-				Type:     schema.TypeSet,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_template_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"vpc_security_group_ids": {
@@ -267,6 +268,78 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				},
 				Set: hashLaunchSpecification,
 			},
+			"launch_template_config": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_specification"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      "$Default",
+										ValidateFunc: validateLaunchTemplateVersion,
+									},
+								},
+							},
+						},
+						"overrides": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"spot_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Everything on a spot fleet is ForceNew except target_capacity
 			"target_capacity": {
 				Type:     schema.TypeInt,
@@ -572,15 +645,9 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotFleet.html
 	conn := meta.(*AWSClient).ec2conn
 
-	launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
-	if err != nil {
-		return err
-	}
-
 	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetRequestConfigData
 	spotFleetConfig := &ec2.SpotFleetRequestConfigData{
 		IamFleetRole:                     aws.String(d.Get("iam_fleet_role").(string)),
-		LaunchSpecifications:             launch_specs,
 		SpotPrice:                        aws.String(d.Get("spot_price").(string)),
 		TargetCapacity:                   aws.Int64(int64(d.Get("target_capacity").(int))),
 		ClientToken:                      aws.String(resource.UniqueId()),
@@ -589,6 +656,18 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 		InstanceInterruptionBehavior:     aws.String(d.Get("instance_interruption_behaviour").(string)),
 	}
 
+	if v, ok := d.GetOk("launch_template_config"); ok {
+		spotFleetConfig.LaunchTemplateConfigs = expandSpotFleetLaunchTemplateConfigs(v.(*schema.Set).List())
+	} else if _, ok := d.GetOk("launch_specification"); ok {
+		launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
+		if err != nil {
+			return err
+		}
+		spotFleetConfig.LaunchSpecifications = launch_specs
+	} else {
+		return fmt.Errorf("One of `launch_specification` or `launch_template_config` must be set for a spot fleet request")
+	}
+
 	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok {
 		spotFleetConfig.ExcessCapacityTerminationPolicy = aws.String(v.(string))
 	}
@@ -659,7 +738,7 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	// Since IAM is eventually consistent, we retry creation as a newly created role may not
 	// take effect immediately, resulting in an InvalidSpotFleetRequestConfig error
 	var resp *ec2.RequestSpotFleetOutput
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 		var err error
 		resp, err = conn.RequestSpotFleet(spotFleetOpts)
 
@@ -890,10 +969,102 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("replace_unhealthy_instances", config.ReplaceUnhealthyInstances)
 	d.Set("instance_interruption_behaviour", config.InstanceInterruptionBehavior)
 	d.Set("launch_specification", launchSpecsToSet(config.LaunchSpecifications, conn))
+	if err := d.Set("launch_template_config", flattenSpotFleetLaunchTemplateConfigs(config.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("error setting launch_template_config: %s", err)
+	}
 
 	return nil
 }
 
+func expandSpotFleetLaunchTemplateConfigs(l []interface{}) []*ec2.LaunchTemplateConfig {
+	configs := make([]*ec2.LaunchTemplateConfig, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		config := &ec2.LaunchTemplateConfig{}
+
+		if v, ok := m["launch_template_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ltm := v[0].(map[string]interface{})
+			spec := &ec2.FleetLaunchTemplateSpecification{}
+
+			if v, ok := ltm["id"].(string); ok && v != "" {
+				spec.LaunchTemplateId = aws.String(v)
+			}
+			if v, ok := ltm["name"].(string); ok && v != "" {
+				spec.LaunchTemplateName = aws.String(v)
+			}
+			if v, ok := ltm["version"].(string); ok && v != "" {
+				spec.Version = aws.String(v)
+			}
+
+			config.LaunchTemplateSpecification = spec
+		}
+
+		for _, v := range m["overrides"].(*schema.Set).List() {
+			om := v.(map[string]interface{})
+			override := &ec2.LaunchTemplateOverrides{}
+
+			if v, ok := om["availability_zone"].(string); ok && v != "" {
+				override.AvailabilityZone = aws.String(v)
+			}
+			if v, ok := om["instance_type"].(string); ok && v != "" {
+				override.InstanceType = aws.String(v)
+			}
+			if v, ok := om["spot_price"].(string); ok && v != "" {
+				override.SpotPrice = aws.String(v)
+			}
+			if v, ok := om["subnet_id"].(string); ok && v != "" {
+				override.SubnetId = aws.String(v)
+			}
+			if v, ok := om["weighted_capacity"].(float64); ok && v > 0 {
+				override.WeightedCapacity = aws.Float64(v)
+			}
+
+			config.Overrides = append(config.Overrides, override)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func flattenSpotFleetLaunchTemplateConfigs(configs []*ec2.LaunchTemplateConfig) []interface{} {
+	l := make([]interface{}, 0, len(configs))
+
+	for _, config := range configs {
+		m := map[string]interface{}{}
+
+		// The version is echoed back as requested, so $Latest and
+		// $Default don't resolve to a number and cause a diff.
+		if spec := config.LaunchTemplateSpecification; spec != nil {
+			m["launch_template_specification"] = []interface{}{
+				map[string]interface{}{
+					"id":      aws.StringValue(spec.LaunchTemplateId),
+					"name":    aws.StringValue(spec.LaunchTemplateName),
+					"version": aws.StringValue(spec.Version),
+				},
+			}
+		}
+
+		overrides := make([]interface{}, 0, len(config.Overrides))
+		for _, override := range config.Overrides {
+			overrides = append(overrides, map[string]interface{}{
+				"availability_zone": aws.StringValue(override.AvailabilityZone),
+				"instance_type":     aws.StringValue(override.InstanceType),
+				"spot_price":        aws.StringValue(override.SpotPrice),
+				"subnet_id":         aws.StringValue(override.SubnetId),
+				"weighted_capacity": aws.Float64Value(override.WeightedCapacity),
+			})
+		}
+		m["overrides"] = overrides
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
 func launchSpecsToSet(launchSpecs []*ec2.SpotFleetLaunchSpecification, conn *ec2.EC2) *schema.Set {
 	specSet := &schema.Set{F: hashLaunchSpecification}
 	for _, spec := range launchSpecs {
@@ -36,6 +36,16 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Spot requests support neither launch templates nor credit
+			// specifications, so the AMI and instance type remain mandatory
+			delete(s, "launch_template")
+			delete(s, "credit_specification")
+			for _, k := range []string{"ami", "instance_type"} {
+				s[k].Optional = false
+				s[k].Computed = false
+				s[k].Required = true
+			}
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -318,7 +328,7 @@ func resourceAwsSpotInstanceRequestUpdate(d *schema.ResourceData, meta interface
 	conn := meta.(*AWSClient).ec2conn
 
 	d.Partial(true)
-	if err := SetTags(conn, d); err != nil {
+	if err := SetTags(conn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
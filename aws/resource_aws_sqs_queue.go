@@ -213,7 +213,7 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
-	if err := SetTagsSQS(sqsconn, d); err != nil {
+	if err := SetTagsSQS(sqsconn, d, meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -359,10 +359,11 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 
 }
 
-func SetTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func SetTagsSQS(conn *sqs.SQS, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		create, remove := DiffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
+		create, remove := DiffTagsGeneric(ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{})), n)
 
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
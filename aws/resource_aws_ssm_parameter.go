@@ -200,7 +200,7 @@ func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating SSM parameter: %s", err)
 	}
 
-	if err := SetTagsSSM(ssmconn, d, d.Get("name").(string), "Parameter"); err != nil {
+	if err := SetTagsSSM(ssmconn, d, d.Get("name").(string), "Parameter", meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return fmt.Errorf("error creating SSM parameter tags: %s", err)
 	}
 
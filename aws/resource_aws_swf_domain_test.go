@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSwfDomain_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSwfDomainConfig_Name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSwfDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_execution_retention_period_in_days", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfDomain_NamePrefix(t *testing.T) {
+	resourceName := "aws_swf_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSwfDomainConfig_NamePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSwfDomainExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(`^tf-acc-test`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfDomain_GeneratedName(t *testing.T) {
+	resourceName := "aws_swf_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSwfDomainConfig_GeneratedName,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSwfDomainExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSwfDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_swf_domain" {
+			continue
+		}
+
+		resp, err := conn.DescribeDomain(&swf.DescribeDomainInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+				continue
+			}
+			return err
+		}
+
+		if status := aws.StringValue(resp.DomainInfo.Status); status != swf.RegistrationStatusDeprecated {
+			return fmt.Errorf("SWF Domain %q is %s, expected %s", rs.Primary.ID, status, swf.RegistrationStatusDeprecated)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSwfDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SWF Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).swfconn
+		resp, err := conn.DescribeDomain(&swf.DescribeDomainInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.DomainInfo.Status); status != swf.RegistrationStatusRegistered {
+			return fmt.Errorf("SWF Domain %q is %s, expected %s", rs.Primary.ID, status, swf.RegistrationStatusRegistered)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSwfDomainConfig_Name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %q
+  description                                 = "Terraform Acceptance Test"
+  workflow_execution_retention_period_in_days = "1"
+}
+`, rName)
+}
+
+const testAccAwsSwfDomainConfig_NamePrefix = `
+resource "aws_swf_domain" "test" {
+  name_prefix                                 = "tf-acc-test"
+  workflow_execution_retention_period_in_days = "1"
+}
+`
+
+const testAccAwsSwfDomainConfig_GeneratedName = `
+resource "aws_swf_domain" "test" {
+  workflow_execution_retention_period_in_days = "1"
+}
+`
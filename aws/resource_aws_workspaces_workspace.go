@@ -0,0 +1,407 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": TagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeGraphics,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputeStandard,
+								workspaces.ComputeValue,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  workspaces.RunningModeAlwaysOn,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value%60 != 0 {
+									errors = append(errors, fmt.Errorf("%q must be a multiple of 60, got: %d", k, value))
+								}
+								return
+							},
+						},
+						"user_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	if tags := TagsFromMapWorkspaces(meta.(*AWSClient).defaultTagsConfig.MergeTags(d.Get("tags").(map[string]interface{}))); len(tags) > 0 {
+		request.Tags = tags
+	}
+
+	input := &workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", input)
+	resp, err := conn.CreateWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("Error creating WorkSpaces Workspace: %s", err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("Error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	if len(resp.PendingRequests) == 0 {
+		return fmt.Errorf("Error creating WorkSpaces Workspace: empty response")
+	}
+
+	d.SetId(aws.StringValue(resp.PendingRequests[0].WorkspaceId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceStatePending},
+		Target: []string{
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateStopped,
+		},
+		Refresh:    workspacesWorkspaceStateRefreshFunc(conn, d.Id(), true),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for WorkSpaces Workspace (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := describeWorkspacesWorkspace(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("Error setting workspace_properties: %s", err)
+	}
+
+	tags, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", TagsToMapWorkspaces(tags.TagList)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("workspace_properties") {
+		input := &workspaces.ModifyWorkspacePropertiesInput{
+			WorkspaceId:         aws.String(d.Id()),
+			WorkspaceProperties: expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Modifying WorkSpaces Workspace properties: %s", input)
+		if _, err := conn.ModifyWorkspaceProperties(input); err != nil {
+			return fmt.Errorf("Error modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{workspaces.WorkspaceStateUpdating},
+			Target: []string{
+				workspaces.WorkspaceStateAvailable,
+				workspaces.WorkspaceStateStopped,
+			},
+			Refresh:    workspacesWorkspaceStateRefreshFunc(conn, d.Id(), true),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for WorkSpaces Workspace (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if err := SetTagsWorkspaces(conn, d, d.Id(), meta.(*AWSClient).defaultTagsConfig, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("Error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", input)
+	resp, err := conn.TerminateWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("Error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("Error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateError,
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateTerminating,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateUpdating,
+		},
+		Target:     []string{workspaces.WorkspaceStateTerminated},
+		Refresh:    workspacesWorkspaceStateRefreshFunc(conn, d.Id(), false),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for WorkSpaces Workspace (%s) to terminate: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeWorkspacesWorkspace(conn *workspaces.WorkSpaces, id string) (*workspaces.Workspace, error) {
+	resp, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workspace := range resp.Workspaces {
+		if aws.StringValue(workspace.WorkspaceId) == id {
+			return workspace, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// workspacesWorkspaceStateRefreshFunc returns a StateRefreshFunc for the given
+// workspace. With failOnError the ERROR state is reported as an error,
+// otherwise it is returned like any other state, e.g. while terminating.
+func workspacesWorkspaceStateRefreshFunc(conn *workspaces.WorkSpaces, id string, failOnError bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workspace, err := describeWorkspacesWorkspace(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Terminated workspaces eventually disappear from the API
+		if workspace == nil {
+			return id, workspaces.WorkspaceStateTerminated, nil
+		}
+
+		state := aws.StringValue(workspace.State)
+		if failOnError && state == workspaces.WorkspaceStateError {
+			return workspace, state, fmt.Errorf("%s: %s", aws.StringValue(workspace.ErrorCode), aws.StringValue(workspace.ErrorMessage))
+		}
+
+		return workspace, state, nil
+	}
+}
+
+func expandWorkspacesWorkspaceProperties(l []interface{}) *workspaces.WorkspaceProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &workspaces.WorkspaceProperties{
+		RunningMode: aws.String(m["running_mode"].(string)),
+	}
+
+	if v, ok := m["compute_type_name"].(string); ok && v != "" {
+		properties.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		properties.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 && m["running_mode"].(string) == workspaces.RunningModeAutoStop {
+		properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		properties.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return properties
+}
+
+func flattenWorkspacesWorkspaceProperties(properties *workspaces.WorkspaceProperties) []map[string]interface{} {
+	if properties == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(properties.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(properties.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(properties.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(properties.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(properties.UserVolumeSizeGib)),
+	}
+
+	return []map[string]interface{}{m}
+}
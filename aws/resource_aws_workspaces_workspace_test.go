@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Workspaces can only be launched into a directory that is registered with
+// Amazon WorkSpaces, which is not yet possible through the provider.
+func testAccAWSWorkspacesWorkspacePreCheck(t *testing.T) string {
+	directoryID := os.Getenv("WORKSPACES_DIRECTORY_ID")
+	if directoryID == "" {
+		t.Skip("Environment variable WORKSPACES_DIRECTORY_ID is not set")
+	}
+	return directoryID
+}
+
+func TestAccAWSWorkspacesWorkspace_basic(t *testing.T) {
+	var v workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+	directoryID := testAccAWSWorkspacesWorkspacePreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig(directoryID, "ALWAYS_ON", "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "Administrator"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "ALWAYS_ON"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "production"),
+					resource.TestCheckResourceAttrSet(resourceName, "bundle_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig(directoryID, "AUTO_STOP", "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "AUTO_STOP"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "staging"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesWorkspaceExists(n string, v *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Workspace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		workspace, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace == nil {
+			return fmt.Errorf("WorkSpaces Workspace %q not found", rs.Primary.ID)
+		}
+
+		*v = *workspace
+
+		return nil
+	}
+}
+
+func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace != nil && aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+			return fmt.Errorf("WorkSpaces Workspace %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsWorkspacesWorkspaceConfig(directoryID, runningMode, environment string) string {
+	return fmt.Sprintf(`
+data "aws_workspaces_bundle" "value_windows" {
+  bundle_id = "wsb-bh8rsxt14" # Value with Windows 10 (English)
+}
+
+resource "aws_workspaces_workspace" "test" {
+  directory_id = "%s"
+  bundle_id    = "${data.aws_workspaces_bundle.value_windows.id}"
+  user_name    = "Administrator"
+
+  workspace_properties {
+    running_mode                              = "%s"
+    running_mode_auto_stop_timeout_in_minutes = 60
+  }
+
+  tags {
+    Environment = "%s"
+  }
+}
+`, directoryID, runningMode, environment)
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+// RetryPolicy controls how requests to an AWS service are retried.
+// Zero values are inherited from the provider-wide default policy.
+type RetryPolicy struct {
+	MaxAttempts         int
+	BackoffBase         time.Duration
+	BackoffCap          time.Duration
+	RetryableErrorCodes []string
+}
+
+// RetryConfig holds the policies configured in the provider's retry blocks.
+// Services are keyed by the SDK endpoint prefix, e.g. "ec2" or "iam".
+type RetryConfig struct {
+	Default  RetryPolicy
+	Services map[string]RetryPolicy
+}
+
+// retryRule marks an error returned by a specific operation as retryable
+// where the SDK would otherwise give up on it.
+type retryRule struct {
+	Service           string
+	Operations        []string
+	OperationPrefixes []string
+	Code              string
+	Message           string
+}
+
+var awsServiceRetryRules = []retryRule{
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+	{
+		Service:           kinesis.ServiceName,
+		OperationPrefixes: []string{"Describe", "List"},
+		Code:              kinesis.ErrCodeLimitExceededException,
+	},
+	{
+		Service:    kinesis.ServiceName,
+		Operations: []string{"CreateStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "simultaneously be in CREATING or DELETING",
+	},
+	{
+		Service:    kinesis.ServiceName,
+		Operations: []string{"CreateStream", "DeleteStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "Rate exceeded for stream",
+	},
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	// Application Auto Scaling shares its endpoint prefix with Auto Scaling,
+	// but the error code is only returned by the former.
+	{
+		Service:           applicationautoscaling.ServiceName,
+		OperationPrefixes: []string{"Describe", "List"},
+		Code:              applicationautoscaling.ErrCodeFailedResourceAccessException,
+	},
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{
+		Service:    dynamodb.ServiceName,
+		Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+		Code:       dynamodb.ErrCodeLimitExceededException,
+		Message:    "Subscriber limit exceeded:",
+	},
+}
+
+func (rule retryRule) matches(r *request.Request) bool {
+	if r.ClientInfo.ServiceName != rule.Service || !isAWSErr(r.Error, rule.Code, rule.Message) {
+		return false
+	}
+
+	for _, op := range rule.Operations {
+		if r.Operation.Name == op {
+			return true
+		}
+	}
+	for _, prefix := range rule.OperationPrefixes {
+		if strings.HasPrefix(r.Operation.Name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Policy returns the effective retry policy for the given service, falling
+// back to the default policy for any setting the service does not override.
+// Retryable error codes are combined with the default ones.
+func (c *RetryConfig) Policy(service string) RetryPolicy {
+	policy := c.Default
+
+	override, ok := c.Services[service]
+	if !ok {
+		return policy
+	}
+
+	if override.MaxAttempts > 0 {
+		policy.MaxAttempts = override.MaxAttempts
+	}
+	if override.BackoffBase > 0 {
+		policy.BackoffBase = override.BackoffBase
+	}
+	if override.BackoffCap > 0 {
+		policy.BackoffCap = override.BackoffCap
+	}
+	if len(override.RetryableErrorCodes) > 0 {
+		codes := make([]string, 0, len(policy.RetryableErrorCodes)+len(override.RetryableErrorCodes))
+		codes = append(codes, policy.RetryableErrorCodes...)
+		policy.RetryableErrorCodes = append(codes, override.RetryableErrorCodes...)
+	}
+
+	return policy
+}
+
+// Handler returns the request handler that applies the retry policy of the
+// request's service. It is registered once on the base session so every
+// service client shares it.
+func (c *RetryConfig) Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RetryPolicyHandler",
+		Fn: func(r *request.Request) {
+			policy := c.Policy(r.ClientInfo.ServiceName)
+			r.Retryer = policy.retryer()
+
+			if policy.isRetryable(r) {
+				r.Retryable = aws.Bool(true)
+			}
+		},
+	}
+}
+
+func (p RetryPolicy) isRetryable(r *request.Request) bool {
+	if err, ok := r.Error.(awserr.Error); ok {
+		for _, code := range p.RetryableErrorCodes {
+			if err.Code() == code {
+				return true
+			}
+		}
+	}
+
+	for _, rule := range awsServiceRetryRules {
+		if rule.matches(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p RetryPolicy) retryer() request.Retryer {
+	maxRetries := p.MaxAttempts - 1
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	return policyRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		base:           p.BackoffBase,
+		cap:            p.BackoffCap,
+	}
+}
+
+// policyRetryer uses the SDK's retry decisions but a configurable
+// exponential backoff. The SDK backoff is kept when neither the base nor the
+// cap is configured; otherwise an unset cap defaults to five minutes.
+type policyRetryer struct {
+	client.DefaultRetryer
+	base time.Duration
+	cap  time.Duration
+}
+
+func (r policyRetryer) RetryRules(req *request.Request) time.Duration {
+	if r.base == 0 && r.cap == 0 {
+		return r.DefaultRetryer.RetryRules(req)
+	}
+
+	base := r.base
+	if base == 0 {
+		base = 30 * time.Millisecond
+	}
+	maxDelay := r.cap
+	if maxDelay == 0 {
+		maxDelay = 5 * time.Minute
+	}
+
+	delay := base
+	for i := 0; i < req.RetryCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// Jitter the delay within its upper half to avoid synchronized retries.
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
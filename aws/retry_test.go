@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func testRetryRequest(service, operation string, err error) *request.Request {
+	return &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: service},
+		Operation:  &request.Operation{Name: operation},
+		Error:      err,
+	}
+}
+
+func TestRetryConfigPolicy(t *testing.T) {
+	config := RetryConfig{
+		Default: RetryPolicy{
+			MaxAttempts:         26,
+			BackoffBase:         100 * time.Millisecond,
+			RetryableErrorCodes: []string{"Throttled"},
+		},
+		Services: map[string]RetryPolicy{
+			"ec2": {
+				MaxAttempts:         10,
+				BackoffCap:          20 * time.Second,
+				RetryableErrorCodes: []string{"InvalidGroup.NotFound"},
+			},
+		},
+	}
+
+	expectedDefault := config.Default
+	if policy := config.Policy("iam"); !reflect.DeepEqual(policy, expectedDefault) {
+		t.Fatalf("expected iam policy %#v, got %#v", expectedDefault, policy)
+	}
+
+	expectedEc2 := RetryPolicy{
+		MaxAttempts:         10,
+		BackoffBase:         100 * time.Millisecond,
+		BackoffCap:          20 * time.Second,
+		RetryableErrorCodes: []string{"Throttled", "InvalidGroup.NotFound"},
+	}
+	if policy := config.Policy("ec2"); !reflect.DeepEqual(policy, expectedEc2) {
+		t.Fatalf("expected ec2 policy %#v, got %#v", expectedEc2, policy)
+	}
+
+	if len(config.Default.RetryableErrorCodes) != 1 {
+		t.Fatalf("expected default retryable error codes to be unchanged, got %v", config.Default.RetryableErrorCodes)
+	}
+}
+
+func TestRetryConfigHandler(t *testing.T) {
+	config := RetryConfig{
+		Default: RetryPolicy{
+			MaxAttempts: 26,
+		},
+		Services: map[string]RetryPolicy{
+			"ec2": {
+				MaxAttempts:         5,
+				RetryableErrorCodes: []string{"InvalidGroup.NotFound"},
+			},
+		},
+	}
+	handler := config.Handler()
+
+	cases := []struct {
+		Request    *request.Request
+		Retryable  bool
+		MaxRetries int
+	}{
+		{
+			Request:    testRetryRequest("ec2", "AuthorizeSecurityGroupIngress", awserr.New("InvalidGroup.NotFound", "", nil)),
+			Retryable:  true,
+			MaxRetries: 4,
+		},
+		{
+			Request:    testRetryRequest("iam", "GetRole", awserr.New("InvalidGroup.NotFound", "", nil)),
+			MaxRetries: 25,
+		},
+		{
+			Request:    testRetryRequest("kinesis", "DescribeStream", awserr.New("LimitExceededException", "", nil)),
+			Retryable:  true,
+			MaxRetries: 25,
+		},
+		{
+			Request:    testRetryRequest("kinesis", "CreateStream", awserr.New("LimitExceededException", "Rate exceeded for stream foo", nil)),
+			Retryable:  true,
+			MaxRetries: 25,
+		},
+		{
+			Request:    testRetryRequest("kinesis", "CreateStream", awserr.New("LimitExceededException", "Too many streams", nil)),
+			MaxRetries: 25,
+		},
+		{
+			Request:    testRetryRequest("dynamodb", "PutItem", awserr.New("LimitExceededException", "Subscriber limit exceeded: foo", nil)),
+			Retryable:  true,
+			MaxRetries: 25,
+		},
+		{
+			Request:    testRetryRequest("autoscaling", "DescribeScalingPolicies", awserr.New("FailedResourceAccessException", "", nil)),
+			Retryable:  true,
+			MaxRetries: 25,
+		},
+	}
+
+	for i, tc := range cases {
+		handler.Fn(tc.Request)
+
+		if retryable := aws.BoolValue(tc.Request.Retryable); retryable != tc.Retryable {
+			t.Fatalf("%d: expected retryable %t, got %t", i, tc.Retryable, retryable)
+		}
+		if maxRetries := tc.Request.MaxRetries(); maxRetries != tc.MaxRetries {
+			t.Fatalf("%d: expected %d max retries, got %d", i, tc.MaxRetries, maxRetries)
+		}
+	}
+}
+
+func TestPolicyRetryerRetryRules(t *testing.T) {
+	retryer := RetryPolicy{
+		MaxAttempts: 10,
+		BackoffBase: 1 * time.Second,
+		BackoffCap:  10 * time.Second,
+	}.retryer()
+
+	cases := []struct {
+		RetryCount int
+		Min        time.Duration
+		Max        time.Duration
+	}{
+		{RetryCount: 0, Min: 500 * time.Millisecond, Max: 1 * time.Second},
+		{RetryCount: 2, Min: 2 * time.Second, Max: 4 * time.Second},
+		{RetryCount: 4, Min: 5 * time.Second, Max: 10 * time.Second},
+		{RetryCount: 100, Min: 5 * time.Second, Max: 10 * time.Second},
+	}
+
+	for _, tc := range cases {
+		r := testRetryRequest("ec2", "DescribeInstances", nil)
+		r.RetryCount = tc.RetryCount
+
+		delay := retryer.RetryRules(r)
+		if delay < tc.Min || delay > tc.Max {
+			t.Fatalf("retry %d: expected delay between %s and %s, got %s", tc.RetryCount, tc.Min, tc.Max, delay)
+		}
+	}
+}
+
+func TestExpandProviderRetryConfig(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{
+			"service":               "",
+			"max_attempts":          30,
+			"backoff_base":          "",
+			"backoff_cap":           "1m",
+			"retryable_error_codes": schema.NewSet(schema.HashString, []interface{}{}),
+		},
+		map[string]interface{}{
+			"service":               "iam",
+			"max_attempts":          0,
+			"backoff_base":          "500ms",
+			"backoff_cap":           "",
+			"retryable_error_codes": schema.NewSet(schema.HashString, []interface{}{"NoSuchEntity"}),
+		},
+	}
+
+	config, err := expandProviderRetryConfig(l)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := RetryConfig{
+		Default: RetryPolicy{
+			MaxAttempts: 30,
+			BackoffCap:  1 * time.Minute,
+		},
+		Services: map[string]RetryPolicy{
+			"iam": {
+				BackoffBase:         500 * time.Millisecond,
+				RetryableErrorCodes: []string{"NoSuchEntity"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, config)
+	}
+
+	if _, err := expandProviderRetryConfig(append(l, l[1])); err == nil {
+		t.Fatal("expected duplicate service retry blocks to error")
+	}
+	if _, err := expandProviderRetryConfig(append(l, l[0])); err == nil {
+		t.Fatal("expected duplicate default retry blocks to error")
+	}
+}
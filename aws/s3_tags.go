@@ -12,13 +12,23 @@ import (
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func SetTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func SetTagsS3(conn *s3.S3, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsS3(TagsFromMapS3(o), TagsFromMapS3(n))
 
+		// Deleting and putting the bucket tagging replaces all the tags of
+		// the bucket, so carry the ignored ones over from it
+		if (len(create) > 0 || len(remove) > 0) && !ignoreTagsConfig.empty() {
+			remote, err := getTagSetS3(conn, d.Get("bucket").(string))
+			if err != nil {
+				return err
+			}
+			create = TagsFromMapS3(ignoreTagsConfig.MergeIgnored(n, TagsToMapS3(remote)))
+		}
+
 		// Set tags
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
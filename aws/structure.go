@@ -3665,6 +3665,18 @@ func flattenDynamoDbTtl(ttlDesc *dynamodb.TimeToLiveDescription) []interface{} {
 	return []interface{}{}
 }
 
+func flattenDynamoDbPitr(desc *dynamodb.ContinuousBackupsDescription) []interface{} {
+	m := map[string]interface{}{
+		"enabled": false,
+	}
+
+	if desc != nil && desc.PointInTimeRecoveryDescription != nil {
+		m["enabled"] = aws.StringValue(desc.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.TableDescription) error {
 	d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 	d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
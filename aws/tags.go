@@ -3,6 +3,7 @@ package aws
 import (
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,28 +16,243 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// TagsSchema returns the schema to use for tags.
+// TagsSchema returns the schema to use for tags. Provider() binds its
+// DiffSuppressFunc to the provider's default_tags.
 //
 func TagsSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeMap,
-		Optional: true,
+		Type:             schema.TypeMap,
+		Optional:         true,
+		DiffSuppressFunc: suppressDefaultTagsDiff(nil),
 	}
 }
 
 func TagsSchemaComputed() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeMap,
-		Optional: true,
-		Computed: true,
+		Type:             schema.TypeMap,
+		Optional:         true,
+		Computed:         true,
+		DiffSuppressFunc: suppressDefaultTagsDiff(nil),
 	}
 }
 
-func SetElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+// DefaultTagsConfig holds the tags configured in the provider's
+// default_tags block.
+type DefaultTagsConfig struct {
+	Tags map[string]interface{}
+}
+
+// MergeTags returns the default tags combined with the given resource tags.
+// Resource tags take precedence over default tags with the same key.
+func (c *DefaultTagsConfig) MergeTags(tags map[string]interface{}) map[string]interface{} {
+	if c == nil || len(c.Tags) == 0 {
+		return tags
+	}
+
+	result := make(map[string]interface{}, len(c.Tags)+len(tags))
+	for k, v := range c.Tags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreTagsConfig holds the tag keys and key prefixes configured in the
+// provider's ignore_tags block.
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// IgnoreKey returns whether the given tag key is managed outside of Terraform
+// and must neither be read into the state nor changed.
+func (c *IgnoreTagsConfig) IgnoreKey(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, key := range c.Keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *IgnoreTagsConfig) empty() bool {
+	return c == nil || (len(c.Keys) == 0 && len(c.KeyPrefixes) == 0)
+}
+
+// RemoveIgnored returns the given tags without the ignored ones, so that the
+// tag helpers neither create nor remove them.
+func (c *IgnoreTagsConfig) RemoveIgnored(tags map[string]interface{}) map[string]interface{} {
+	if c.empty() {
+		return tags
+	}
+
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if c.IgnoreKey(k) {
+			log.Printf("[DEBUG] Found tag %s in ignore_tags, ignoring.\n", k)
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// MergeIgnored returns the given tags, without the ignored ones, combined with
+// the ignored tags currently on the resource. APIs taking the replacement
+// set of tags would otherwise remove the ignored tags.
+func (c *IgnoreTagsConfig) MergeIgnored(tags map[string]interface{}, remote map[string]string) map[string]interface{} {
+	if c.empty() {
+		return tags
+	}
+
+	result := c.RemoveIgnored(tags)
+	for k, v := range remote {
+		if c.IgnoreKey(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// ignoredTagsAttributes are the attributes that ignore_tags applies to: the
+// tag maps, and the autoscaling group tag lists keyed by "key".
+var ignoredTagsAttributes = []string{"tags", "volume_tags", "tag"}
+
+// ignoreTagsFunc wraps a function reading the tags of a resource or data
+// source so that the tags ignored in the provider's ignore_tags are removed
+// from them afterwards.
+func ignoreTagsFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		return removeIgnoredTags(d, meta.(*AWSClient).ignoreTagsConfig)
+	}
+}
+
+// removeIgnoredTags removes the tags matching the given ignore_tags
+// configuration from the tag attributes of d.
+func removeIgnoredTags(d *schema.ResourceData, config *IgnoreTagsConfig) error {
+	if config == nil || d.Id() == "" {
+		return nil
+	}
+
+	for _, k := range ignoredTagsAttributes {
+		switch v := d.Get(k).(type) {
+		case map[string]interface{}:
+			tags := make(map[string]interface{}, len(v))
+			for tk, tv := range v {
+				if config.IgnoreKey(tk) {
+					log.Printf("[DEBUG] Found tag %s in ignore_tags, ignoring.\n", tk)
+					continue
+				}
+				tags[tk] = tv
+			}
+			if len(tags) == len(v) {
+				continue
+			}
+			if err := d.Set(k, tags); err != nil {
+				return err
+			}
+		case []interface{}:
+			if tags := removeIgnoredTagsList(v, config); len(tags) != len(v) {
+				if err := d.Set(k, tags); err != nil {
+					return err
+				}
+			}
+		case *schema.Set:
+			if tags := removeIgnoredTagsList(v.List(), config); len(tags) != v.Len() {
+				if err := d.Set(k, tags); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasIgnoredTagsAttributes returns whether r has any of the attributes that
+// ignore_tags applies to.
+func hasIgnoredTagsAttributes(r *schema.Resource) bool {
+	for _, k := range ignoredTagsAttributes {
+		if _, ok := r.Schema[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func removeIgnoredTagsList(l []interface{}, config *IgnoreTagsConfig) []interface{} {
+	tags := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if m, ok := raw.(map[string]interface{}); ok {
+			if k, ok := m["key"].(string); ok && config.IgnoreKey(k) {
+				log.Printf("[DEBUG] Found tag %s in ignore_tags, ignoring.\n", k)
+				continue
+			}
+		}
+		tags = append(tags, raw)
+	}
+	return tags
+}
+
+// suppressDefaultTagsDiff returns a DiffSuppressFunc that suppresses
+// differences in "tags" that only exist because the default tags returned by
+// config are present on the resource but are not repeated in its
+// configuration. A nil config never suppresses anything.
+func suppressDefaultTagsDiff(config func() *DefaultTagsConfig) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if config == nil {
+			return false
+		}
+		defaultTagsConfig := config()
+		if defaultTagsConfig == nil || len(defaultTagsConfig.Tags) == 0 {
+			return false
+		}
+		if !strings.HasPrefix(k, "tags.") {
+			return false
+		}
+
+		key := strings.TrimPrefix(k, "tags.")
+		if key == "%" {
+			// Without any configured tags d.Get would fall back to the state
+			var tags map[string]interface{}
+			if new != "0" {
+				tags = d.Get("tags").(map[string]interface{})
+			}
+			return old == strconv.Itoa(len(defaultTagsConfig.MergeTags(tags)))
+		}
+
+		if new != "" {
+			return false
+		}
+		v, ok := defaultTagsConfig.Tags[key]
+		return ok && v.(string) == old
+	}
+}
+
+func SetElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffElbV2Tags(TagsFromMapELBv2(o), TagsFromMapELBv2(n))
 
 		// Set tags
@@ -69,11 +285,11 @@ func SetElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
 	return nil
 }
 
-func SetVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func SetVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreTagsConfig *IgnoreTagsConfig) error {
 	if d.HasChange("volume_tags") {
 		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(nraw.(map[string]interface{}))
 		create, remove := DiffTags(TagsFromMap(o), TagsFromMap(n))
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
@@ -128,11 +344,11 @@ func SetVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func SetTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func SetTags(conn *ec2.EC2, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTags(TagsFromMap(o), TagsFromMap(n))
 
 		// Set tags
@@ -332,11 +548,11 @@ func TagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // This is needed because dynamodb requires a completely different set and delete
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
-func SetTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
+func SetTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
 	arn := d.Get("arn").(string)
 	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
+	o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+	n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 	create, remove := DiffTagsDynamoDb(TagsFromMapDynamoDb(o), TagsFromMapDynamoDb(n))
 
 	// Set tags
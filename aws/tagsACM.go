@@ -9,11 +9,11 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func SetTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func SetTagsACM(conn *acm.ACM, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsACM(TagsFromMapACM(o), TagsFromMapACM(n))
 
 		// Set tags
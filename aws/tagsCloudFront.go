@@ -8,11 +8,11 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func SetTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
+func SetTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsCloudFront(TagsFromMapCloudFront(o), TagsFromMapCloudFront(n))
 
 		if len(remove) > 0 {
@@ -11,11 +11,11 @@ import (
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func SetTagsSSM(conn *ssm.SSM, d *schema.ResourceData, id, resourceType string) error {
-	if d.HasChange("tags") {
+func SetTagsSSM(conn *ssm.SSM, d *schema.ResourceData, id, resourceType string, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsSSM(TagsFromMapSSM(o), TagsFromMapSSM(n))
 
 		// Set tags
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// SetTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func SetTagsSagemaker(conn *sagemaker.SageMaker, d *schema.ResourceData, arn string, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
+		oraw, nraw := d.GetChange("tags")
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
+		create, remove := DiffTagsGeneric(o, n)
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			keys := make([]*string, 0, len(remove))
+			for k := range remove {
+				keys = append(keys, aws.String(k))
+			}
+
+			_, err := conn.DeleteTags(&sagemaker.DeleteTagsInput{
+				ResourceArn: aws.String(arn),
+				TagKeys:     keys,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			_, err := conn.AddTags(&sagemaker.AddTagsInput{
+				ResourceArn: aws.String(arn),
+				Tags:        tagsFromGenericSagemaker(create),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// TagsFromMap returns the tags for the given map of data.
+func TagsFromMapSagemaker(m map[string]interface{}) []*sagemaker.Tag {
+	return tagsFromGenericSagemaker(TagsFromMapGeneric(m))
+}
+
+// TagsToMap turns the list of tags into a map.
+func TagsToMapSagemaker(ts []*sagemaker.Tag) map[string]string {
+	m := make(map[string]*string, len(ts))
+	for _, t := range ts {
+		m[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return TagsToMapGeneric(m)
+}
+
+func tagsFromGenericSagemaker(m map[string]*string) []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(m))
+	for k, v := range m {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		})
+	}
+
+	return result
+}
+
+// listTagsSagemaker returns all of the tags of a SageMaker resource.
+func listTagsSagemaker(conn *sagemaker.SageMaker, arn string) ([]*sagemaker.Tag, error) {
+	var tags []*sagemaker.Tag
+
+	input := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(arn),
+	}
+	for {
+		resp, err := conn.ListTags(input)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, resp.Tags...)
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return tags, nil
+}
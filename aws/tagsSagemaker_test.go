@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+)
+
+func TestTagsSagemaker(t *testing.T) {
+	tags := TagsFromMapSagemaker(map[string]interface{}{
+		"foo":     "bar",
+		"aws:foo": "ignored",
+	})
+	if len(tags) != 1 || aws.StringValue(tags[0].Key) != "foo" || aws.StringValue(tags[0].Value) != "bar" {
+		t.Fatalf("bad tags: %#v", tags)
+	}
+
+	m := TagsToMapSagemaker([]*sagemaker.Tag{
+		{Key: aws.String("foo"), Value: aws.String("bar")},
+		{Key: aws.String("aws:cloudformation:logical-id"), Value: aws.String("baz")},
+	})
+	expected := map[string]string{
+		"foo": "bar",
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad map: %#v", m)
+	}
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// SetTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func SetTagsWorkspaces(conn *workspaces.WorkSpaces, d *schema.ResourceData, resourceId string, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
+	if d.HasChange("tags") || d.IsNewResource() {
+		oraw, nraw := d.GetChange("tags")
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
+		create, remove := DiffTagsWorkspaces(TagsFromMapWorkspaces(o), TagsFromMapWorkspaces(n))
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %s", remove)
+			k := make([]*string, len(remove), len(remove))
+			for i, t := range remove {
+				k[i] = t.Key
+			}
+
+			_, err := conn.DeleteTags(&workspaces.DeleteTagsInput{
+				ResourceId: aws.String(resourceId),
+				TagKeys:    k,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %s", create)
+			_, err := conn.CreateTags(&workspaces.CreateTagsInput{
+				ResourceId: aws.String(resourceId),
+				Tags:       create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DiffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func DiffTagsWorkspaces(oldTags, newTags []*workspaces.Tag) ([]*workspaces.Tag, []*workspaces.Tag) {
+	// First, we're creating everything we have
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = aws.StringValue(t.Value)
+	}
+
+	// Build the list of what to remove
+	var remove []*workspaces.Tag
+	for _, t := range oldTags {
+		old, ok := create[*t.Key]
+		if !ok || old != aws.StringValue(t.Value) {
+			// Delete it!
+			remove = append(remove, t)
+		}
+	}
+
+	return TagsFromMapWorkspaces(create), remove
+}
+
+// TagsFromMap returns the tags for the given map of data.
+func TagsFromMapWorkspaces(m map[string]interface{}) []*workspaces.Tag {
+	result := make([]*workspaces.Tag, 0, len(m))
+	for k, v := range m {
+		t := &workspaces.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		}
+		if !TagIgnoredWorkspaces(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// TagsToMap turns the list of tags into a map.
+func TagsToMapWorkspaces(ts []*workspaces.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		if !TagIgnoredWorkspaces(t) {
+			result[*t.Key] = aws.StringValue(t.Value)
+		}
+	}
+
+	return result
+}
+
+// compare a tag against a list of strings and checks if it should
+// be ignored or not
+func TagIgnoredWorkspaces(t *workspaces.Tag) bool {
+	filter := []string{"^aws:"}
+	for _, v := range filter {
+		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
+		if r, _ := regexp.MatchString(v, *t.Key); r == true {
+			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, aws.StringValue(t.Value))
+			return true
+		}
+	}
+	return false
+}
@@ -59,10 +59,10 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := meta.(*AWSClient).ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := meta.(*AWSClient).ignoreTagsConfig.RemoveIgnored(meta.(*AWSClient).defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 
 		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
 
@@ -11,14 +11,14 @@ import (
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func SetTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+func SetTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, ignoreTagsConfig *IgnoreTagsConfig) error {
 
 	sn := d.Get("name").(string)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.IsNewResource() {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		o := ignoreTagsConfig.RemoveIgnored(oraw.(map[string]interface{}))
+		n := ignoreTagsConfig.RemoveIgnored(defaultTagsConfig.MergeTags(nraw.(map[string]interface{})))
 		create, remove := DiffTagsKinesis(TagsFromMapKinesis(o), TagsFromMapKinesis(n))
 
 		// Set tags
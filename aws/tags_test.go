@@ -7,7 +7,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -80,6 +82,149 @@ func TestIgnoringTags(t *testing.T) {
 	}
 }
 
+func TestDefaultTagsConfigMergeTags(t *testing.T) {
+	cases := []struct {
+		Config   *DefaultTagsConfig
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Config: nil,
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Expected: map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+		{
+			Config: &DefaultTagsConfig{
+				Tags: map[string]interface{}{
+					"env": "prod",
+				},
+			},
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Expected: map[string]interface{}{
+				"env": "prod",
+				"foo": "bar",
+			},
+		},
+		// Resource tags win over default tags
+		{
+			Config: &DefaultTagsConfig{
+				Tags: map[string]interface{}{
+					"env": "prod",
+				},
+			},
+			Tags: map[string]interface{}{
+				"env": "test",
+			},
+			Expected: map[string]interface{}{
+				"env": "test",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		merged := tc.Config.MergeTags(tc.Tags)
+		if !reflect.DeepEqual(merged, tc.Expected) {
+			t.Fatalf("%d: bad merged tags: %#v", i, merged)
+		}
+	}
+}
+
+func TestSuppressDefaultTagsDiff(t *testing.T) {
+	defaultTagsConfig := &DefaultTagsConfig{
+		Tags: map[string]interface{}{
+			"env": "prod",
+		},
+	}
+
+	tags := TagsSchema()
+	tags.DiffSuppressFunc = suppressDefaultTagsDiff(func() *DefaultTagsConfig {
+		return defaultTagsConfig
+	})
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags,
+		},
+	}
+
+	cases := []struct {
+		State      map[string]string
+		Config     map[string]interface{}
+		ExpectDiff bool
+	}{
+		// Default tag only present on the resource
+		{
+			State: map[string]string{
+				"tags.%":    "2",
+				"tags.env":  "prod",
+				"tags.Name": "foo",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Name": "foo",
+				},
+			},
+			ExpectDiff: false,
+		},
+		// Resource without tags of its own
+		{
+			State: map[string]string{
+				"tags.%":   "1",
+				"tags.env": "prod",
+			},
+			Config:     map[string]interface{}{},
+			ExpectDiff: false,
+		},
+		// Default tag overridden on the resource
+		{
+			State: map[string]string{
+				"tags.%":   "1",
+				"tags.env": "prod",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"env": "test",
+				},
+			},
+			ExpectDiff: true,
+		},
+		// Resource tag removed
+		{
+			State: map[string]string{
+				"tags.%":    "2",
+				"tags.env":  "prod",
+				"tags.Name": "foo",
+			},
+			Config:     map[string]interface{}{},
+			ExpectDiff: true,
+		},
+	}
+
+	for i, tc := range cases {
+		state := &terraform.InstanceState{
+			ID:         "i-abcd1234",
+			Attributes: tc.State,
+		}
+		c, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(c), nil)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if hasDiff := diff != nil && !diff.Empty(); hasDiff != tc.ExpectDiff {
+			t.Fatalf("%d: expected diff %t, got: %#v", i, tc.ExpectDiff, diff)
+		}
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
@@ -102,3 +247,95 @@ func testAccCheckTags(
 		return nil
 	}
 }
+
+func TestIgnoringTagsConfigured(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagsConfig: &IgnoreTagsConfig{
+			Keys:        []string{"CreatedBy"},
+			KeyPrefixes: []string{"backup:"},
+		},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": TagsSchema(),
+			"tag":  autoscalingTagSchema(),
+		},
+	}
+	read := ignoreTagsFunc(func(d *schema.ResourceData, meta interface{}) error {
+		d.Set("tags", TagsToMap([]*ec2.Tag{
+			{
+				Key:   aws.String("CreatedBy"),
+				Value: aws.String("cost-tooling"),
+			},
+			{
+				Key:   aws.String("backup:plan"),
+				Value: aws.String("daily"),
+			},
+			{
+				Key:   aws.String("Name"),
+				Value: aws.String("backup:plan"),
+			},
+		}))
+		d.Set("tag", []interface{}{
+			map[string]interface{}{
+				"key":                 "CreatedBy",
+				"value":               "cost-tooling",
+				"propagate_at_launch": true,
+			},
+			map[string]interface{}{
+				"key":                 "Name",
+				"value":               "foo",
+				"propagate_at_launch": true,
+			},
+		})
+		return nil
+	})
+
+	d := r.TestResourceData()
+	d.SetId("foo")
+	if err := read(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	m := d.Get("tags").(map[string]interface{})
+	if len(m) != 1 || m["Name"] != "backup:plan" {
+		t.Fatalf("bad tags: %#v", m)
+	}
+	l := d.Get("tag").(*schema.Set).List()
+	if len(l) != 1 || l[0].(map[string]interface{})["key"] != "Name" {
+		t.Fatalf("bad tag: %#v", l)
+	}
+}
+
+func TestIgnoreTagsConfigMergeIgnored(t *testing.T) {
+	c := &IgnoreTagsConfig{
+		Keys:        []string{"CreatedBy"},
+		KeyPrefixes: []string{"backup:"},
+	}
+	tags := map[string]interface{}{
+		"Name":        "foo",
+		"backup:plan": "weekly",
+	}
+	remote := map[string]string{
+		"Name":        "bar",
+		"CreatedBy":   "cost-tooling",
+		"backup:plan": "daily",
+		"Environment": "test",
+	}
+
+	merged := c.MergeIgnored(tags, remote)
+	expected := map[string]interface{}{
+		"Name":        "foo",
+		"CreatedBy":   "cost-tooling",
+		"backup:plan": "daily",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("bad merged tags: %#v", merged)
+	}
+
+	removed := c.RemoveIgnored(tags)
+	if !reflect.DeepEqual(removed, map[string]interface{}{"Name": "foo"}) {
+		t.Fatalf("bad tags: %#v", removed)
+	}
+}
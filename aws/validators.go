@@ -561,6 +561,32 @@ func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be less than 3 characters", k))
+	}
+	if len(value) > 128 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 128 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9A-Za-z()./_-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and ()./_- allowed in %q", k))
+	}
+	return
+}
+
+func validateLaunchTemplateVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "$Latest" && value != "$Default" && !regexp.MustCompile(`^[1-9][0-9]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a version number, $Latest or $Default", k))
+	}
+	return
+}
+
 func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
@@ -1740,3 +1766,65 @@ func validateDynamoDbTableAttributes(d *schema.ResourceDiff) error {
 
 	return nil
 }
+
+func validateAwsOrganizationsAccountEmail(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid email address", value))
+	}
+
+	if len(value) < 6 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be less than 6 characters", value))
+	}
+
+	if len(value) > 64 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 64 characters", value))
+	}
+
+	return
+}
+
+func validateAwsOrganizationsAccountRoleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[\w+=,.@-]{1,64}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must consist of uppercase letters, lowercase letters, digits with no spaces, and any of the following characters: =,.@-", value))
+	}
+
+	return
+}
+
+func validateSagemakerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q: %q", k, value))
+	}
+
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 63 characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateRetryDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
@@ -608,6 +608,64 @@ func TestResourceAWSElastiCacheClusterIdValidation(t *testing.T) {
 	}
 }
 
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name",
+		"Valid_Name.1",
+		"path/to(template)",
+		strings.Repeat("W", 128),
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"name with spaces",
+		"name*with$symbols",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template Name", v)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateVersion(t *testing.T) {
+	validVersions := []string{
+		"1",
+		"42",
+		"$Latest",
+		"$Default",
+	}
+	for _, v := range validVersions {
+		_, errors := validateLaunchTemplateVersion(v, "version")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template version: %q", v, errors)
+		}
+	}
+
+	invalidVersions := []string{
+		"",
+		"0",
+		"latest",
+		"$LATEST",
+		"1.0",
+	}
+	for _, v := range invalidVersions {
+		_, errors := validateLaunchTemplateVersion(v, "version")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template version", v)
+		}
+	}
+}
+
 func TestValidateDbEventSubscriptionName(t *testing.T) {
 	validNames := []string{
 		"valid-name",
@@ -2521,3 +2579,103 @@ func TestValidateAmazonSideAsn(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAwsOrganizationsAccountEmail(t *testing.T) {
+	validEmails := []string{
+		"a@b.cc",
+		"a@b.ccc",
+		"a@b.cccc",
+		"a@b.ccccc",
+		"a@b.cccccc",
+		"a@b.c.cc",
+		"a.b@c.dd",
+		"a+b@c.dd",
+	}
+
+	for _, v := range validEmails {
+		_, errors := validateAwsOrganizationsAccountEmail(v, "email")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid email address: %q", v, errors)
+		}
+	}
+
+	invalidEmails := []string{
+		"a@b",
+		"a@b.",
+		"a@b.c",
+		"a b@c.dd",
+		"@b.cc",
+		"a@.cc",
+		strings.Repeat("a", 60) + "@b.cc",
+	}
+
+	for _, v := range invalidEmails {
+		_, errors := validateAwsOrganizationsAccountEmail(v, "email")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid email address", v)
+		}
+	}
+}
+
+func TestValidateAwsOrganizationsAccountRoleName(t *testing.T) {
+	validNames := []string{
+		"OrganizationAccountAccessRole",
+		"HyphenName-With-Numbers-123",
+		"Role+With=Special,Chars.@-",
+		strings.Repeat("W", 64),
+	}
+
+	for _, v := range validNames {
+		_, errors := validateAwsOrganizationsAccountRoleName(v, "role_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid role name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"Role With Spaces",
+		"Role/With/Slashes",
+		strings.Repeat("W", 65),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validateAwsOrganizationsAccountRoleName(v, "role_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid role name", v)
+		}
+	}
+}
+
+func TestValidateSagemakerName(t *testing.T) {
+	validNames := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+
+	for _, v := range validNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-invalid",
+		"invalid-",
+		"Invalid_Name",
+		"Invalid Name",
+		strings.Repeat("W", 64),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}